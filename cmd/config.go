@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/0xjuanma/golazo/internal/theme"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// configFlags holds the raw values of the configuration override flags, by option key.
+var configFlags = map[string]*string{}
+
+// registerConfigFlags adds a persistent flag for every configuration option.
+func registerConfigFlags(cmd *cobra.Command) {
+	for _, opt := range data.ConfigOptions {
+		value := new(string)
+		configFlags[opt.Key] = value
+		usage := fmt.Sprintf("%s (overrides %s)", opt.Description, opt.Key)
+		if opt.IsBool {
+			cmd.PersistentFlags().Var(boolFlagValue{value}, opt.Flag(), usage)
+			cmd.PersistentFlags().Lookup(opt.Flag()).NoOptDefVal = "true"
+			continue
+		}
+		cmd.PersistentFlags().StringVar(value, opt.Flag(), "", usage)
+	}
+}
+
+// boolFlagValue holds a boolean option's raw value, so it is parsed and reported
+// like the other options, while its flag reads as a boolean one (--accessible).
+type boolFlagValue struct{ value *string }
+
+func (v boolFlagValue) String() string     { return *v.value }
+func (v boolFlagValue) Set(s string) error { *v.value = s; return nil }
+func (v boolFlagValue) Type() string       { return "bool" }
+
+// loadEffectiveConfig loads config.yaml and applies environment and flag overrides,
+// in that order of precedence. The result is not validated.
+func loadEffectiveConfig(cmd *cobra.Command) (*data.Config, error) {
+	cfg, err := data.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.ApplyEnv(); err != nil {
+		return nil, err
+	}
+	for _, opt := range data.ConfigOptions {
+		if flag := cmd.Flags().Lookup(opt.Flag()); flag != nil && flag.Changed {
+			if err := opt.Set(cfg, *configFlags[opt.Key]); err != nil {
+				return nil, fmt.Errorf("--%s: %w", opt.Flag(), err)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// applyConfig loads, validates and activates the configuration before any command runs,
+// and selects the profile and checks its settings file.
+// Version and update requests skip it so a broken configuration never blocks them.
+func applyConfig(cmd *cobra.Command, args []string) error {
+	if versionFlag || updateFlag {
+		return nil
+	}
+
+	// Configuration errors are printed by Execute, without usage
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	cfg, err := loadEffectiveConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("%w\n\nRun 'golazo config edit' to fix the configuration file", err)
+	}
+	data.SetCurrentConfig(cfg)
+	if flag := cmd.Flags().Lookup("theme"); (flag != nil && flag.Changed) || os.Getenv("GOLAZO_THEME") != "" {
+		data.OverrideTheme()
+	}
+
+	profile := profileFlag
+	if profile == "" {
+		profile = os.Getenv("GOLAZO_PROFILE")
+	}
+	if profile != "" {
+		if err := data.SetActiveProfile(profile); err != nil {
+			return err
+		}
+	}
+
+	// Upgrade the profile's settings up front, and refuse to run (and later save) over a corrupt one
+	if _, err := data.LoadSettings(); err != nil {
+		return fmt.Errorf("%w\n\nFix or remove the file; it has been left untouched", err)
+	}
+	ui.SetAccessible(cfg.Accessible)
+	km, _ := cfg.KeyMap() // Checked by Validate
+	keymap.Set(km)
+	theme.SetCustom(cfg.Themes)
+	return ui.ApplyTheme(data.ThemeName())
+}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show, edit and validate the configuration",
+	Long: `Manage golazo's configuration file (config.yaml in the config directory).
+
+Every value can also be overridden with a GOLAZO_* environment variable or a flag,
+e.g. live.batch_size with GOLAZO_LIVE_BATCH_SIZE=8 or --live-batch-size=8.`,
+	// Config subcommands report configuration errors themselves, printed by Execute without usage
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		return nil
+	},
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Print the effective configuration, including overrides",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadEffectiveConfig(cmd)
+		if err != nil {
+			return err
+		}
+		out, err := cfg.YAML()
+		if err != nil {
+			return err
+		}
+		fmt.Print(out)
+		return nil
+	},
+}
+
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the path of the configuration file",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := data.ConfigPath()
+		if err != nil {
+			return err
+		}
+		fmt.Println(path)
+		return nil
+	},
+}
+
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Open the configuration file in $EDITOR, creating it if needed",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := data.WriteDefaultConfig()
+		if err != nil {
+			return fmt.Errorf("create config: %w", err)
+		}
+
+		editor := os.Getenv("VISUAL")
+		if editor == "" {
+			editor = os.Getenv("EDITOR")
+		}
+		if editor == "" {
+			editor = "vi"
+			if runtime.GOOS == "windows" {
+				editor = "notepad"
+			}
+		}
+
+		// The editor may include arguments, e.g. "code --wait"
+		parts := strings.Fields(editor)
+		c := exec.Command(parts[0], append(parts[1:], path)...)
+		c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := c.Run(); err != nil {
+			return fmt.Errorf("run editor %s: %w", editor, err)
+		}
+		return validateConfig(cmd)
+	},
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration file and overrides for errors",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return validateConfig(cmd)
+	},
+}
+
+// validateConfig reports whether the effective configuration is valid.
+func validateConfig(cmd *cobra.Command) error {
+	cfg, err := loadEffectiveConfig(cmd)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	fmt.Println("Configuration is valid")
+	return nil
+}
+
+func init() {
+	configCmd.AddCommand(configShowCmd, configPathCmd, configEditCmd, configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+}
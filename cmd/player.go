@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// playerOutputWidth is the width used to render a player profile in the terminal.
+const playerOutputWidth = 60
+
+var playerCmd = &cobra.Command{
+	Use:   "player <name|id>",
+	Short: "Show a player's profile and season statistics",
+	Long:  `Look up a player by FotMob ID or by name and show their club, position, appearances, goals, assists and rating for the current season.`,
+	Args:  cobra.MinimumNArgs(1),
+	// Errors are printed by Execute; usage is only useful for argument errors
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runPlayer(strings.Join(args, " "))
+	},
+}
+
+// runPlayer resolves the player query and prints the profile.
+// Numeric queries are treated as player IDs; anything else is searched by name.
+func runPlayer(query string) error {
+	client := fotmob.NewClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	var others []string
+	playerID, err := strconv.Atoi(query)
+	if err != nil {
+		results, err := client.SearchPlayers(ctx, query)
+		if err != nil {
+			return fmt.Errorf("search player %q: %w", query, err)
+		}
+		if len(results) == 0 {
+			return fmt.Errorf("no player found matching %q", query)
+		}
+		playerID = results[0].ID
+
+		// Mention other close matches so the user can refine the query
+		for _, r := range results[1:min(len(results), 5)] {
+			others = append(others, fmt.Sprintf("%s (%s, id %d)", r.Name, r.TeamName, r.ID))
+		}
+	}
+
+	profile, err := client.PlayerProfile(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(ui.RenderPlayerProfile(profile, playerOutputWidth))
+	if len(others) > 0 {
+		fmt.Printf("\nOther matches: %s\n", strings.Join(others, ", "))
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(playerCmd)
+}
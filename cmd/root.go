@@ -1,15 +1,18 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/app"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/version"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -22,11 +25,14 @@ var mockFlag bool
 var updateFlag bool
 var versionFlag bool
 var debugFlag bool
+var profileFlag string
 
 var rootCmd = &cobra.Command{
 	Use:   "golazo",
 	Short: "The beautiful game in your terminal",
 	Long:  `A minimal TUI for following football matches in real-time. Get live match updates, finished match statistics, and minute-by-minute events directly in your terminal.`,
+	// Load config.yaml with environment and flag overrides for every command
+	PersistentPreRunE: applyConfig,
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag {
 			version.Print(Version)
@@ -65,14 +71,39 @@ var rootCmd = &cobra.Command{
 			}
 		}()
 
-		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version), tea.WithAltScreen())
+		// Refresh the league catalogue in background (non-blocking, weekly)
+		if !mockFlag && data.ShouldRefreshLeagueCatalog() {
+			go refreshLeagueCatalog()
+		}
+
+		opts := []tea.ProgramOption{tea.WithAltScreen()}
+		if data.CurrentConfig().Mouse {
+			opts = append(opts, tea.WithMouseCellMotion())
+		}
+		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version), opts...)
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 			os.Exit(1)
 		}
+
+		// Corrupt cache files were rebuilt during the run; say where the originals went
+		for _, recovered := range data.RecoveredFiles() {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", recovered)
+		}
 	},
 }
 
+// refreshLeagueCatalog fetches the provider's league catalogue and caches it on disk.
+// Failures are ignored: the built-in leagues and any previous catalogue remain available.
+func refreshLeagueCatalog() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	if leagues, err := fotmob.NewClient().LeagueCatalog(ctx); err == nil {
+		_ = data.SaveLeagueCatalog(leagues)
+	}
+}
+
 // runUpdate executes the appropriate update method based on installation detection.
 func runUpdate() {
 	installMethod := detectInstallationMethod()
@@ -155,4 +186,6 @@ func init() {
 	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to ~/.golazo/golazo_debug.log")
 	rootCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Update golazo to the latest version")
 	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display version information")
+	rootCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", "Settings profile to use, created on first save (or GOLAZO_PROFILE)")
+	registerConfigFlags(rootCmd)
 }
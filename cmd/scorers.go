@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// scorersOutputWidth is the width used to render leaderboards in the terminal.
+const scorersOutputWidth = 60
+
+// scorersLimit is the number of players printed per leaderboard.
+var scorersLimit int
+
+var scorersCmd = &cobra.Command{
+	Use:   "scorers <league|id>",
+	Short: "Show a league's top scorers, assists and clean sheets",
+	Long:  `Show the season leaderboards of a supported league, given by name (e.g. "premier league") or FotMob ID: top scorers, assist providers and goalkeepers with the most clean sheets.`,
+	Args:  cobra.MinimumNArgs(1),
+	// Errors are printed by Execute; usage is only useful for argument errors
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runScorers(strings.Join(args, " "))
+	},
+}
+
+// runScorers resolves the league and prints its leaderboards.
+// Unknown numeric queries are passed through as FotMob league IDs.
+func runScorers(query string) error {
+	league, ok := data.FindLeague(query)
+	if !ok {
+		id, err := strconv.Atoi(query)
+		if err != nil {
+			return fmt.Errorf("no supported league matching %q", query)
+		}
+		league = data.LeagueInfo{ID: id, Name: fmt.Sprintf("League %d", id)}
+	}
+
+	client := fotmob.NewClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	stats, err := client.LeagueStats(ctx, league.ID, league.Name)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(league.Name)
+	for i, category := range ui.LeagueStatCategories {
+		leaders := ui.LeagueStatLeaders(stats, i)
+		leaders = leaders[:min(len(leaders), max(scorersLimit, 1))]
+		fmt.Printf("\n%s\n%s\n", category.Title, ui.RenderStatLeaders(leaders, category.Label, scorersOutputWidth, -1))
+	}
+	return nil
+}
+
+func init() {
+	scorersCmd.Flags().IntVarP(&scorersLimit, "limit", "n", 10, "Number of players per leaderboard")
+	rootCmd.AddCommand(scorersCmd)
+}
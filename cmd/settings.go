@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importReplace     bool
+	importKeepUnknown bool
+)
+
+var settingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "Export and import league selections and favourite teams",
+	Long: `Share a profile's selected leagues, favourite teams and notification rules as a YAML file.
+
+Export writes the active profile (see --profile) to stdout; import reads a file into it.`,
+}
+
+var settingsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the active profile's settings as YAML",
+	Example: `  golazo settings export > leagues.yaml
+  golazo --profile work settings export`,
+	Args:          cobra.NoArgs,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		settings, err := data.LoadSettings()
+		if err != nil {
+			return err
+		}
+		out, err := data.ExportSettings(settings)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(out))
+		return nil
+	},
+}
+
+var settingsImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import settings into the active profile",
+	Long: `Import settings exported with 'golazo settings export' into the active profile.
+
+By default the file is merged: its leagues and favourite teams are added to the
+profile's, and its notification rules (if any) are used. With --replace the
+profile's settings are replaced by the file's.
+
+League IDs are checked against the league catalogue; unknown ones are reported
+and skipped unless --keep-unknown is given.`,
+	Args:          cobra.ExactArgs(1),
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runSettingsImport(args[0])
+	},
+}
+
+// runSettingsImport reads the file and imports it into the active profile.
+func runSettingsImport(path string) error {
+	imported, err := data.ReadSettingsFile(path)
+	if err != nil {
+		return err
+	}
+
+	// Validate against an up-to-date catalogue, not only the built-in leagues
+	if data.ShouldRefreshLeagueCatalog() {
+		refreshLeagueCatalog()
+	}
+
+	mode, modeName := data.ImportMerge, "merged"
+	if importReplace {
+		mode, modeName = data.ImportReplace, "replaced"
+	}
+
+	result, err := data.ImportSettings(imported, mode, importKeepUnknown)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Unknown) > 0 {
+		ids := make([]string, len(result.Unknown))
+		for i, id := range result.Unknown {
+			ids[i] = strconv.Itoa(id)
+		}
+		action := "skipped"
+		if importKeepUnknown {
+			action = "kept"
+		}
+		fmt.Fprintf(os.Stderr, "Unknown league IDs %s: %s\n", action, strings.Join(ids, ", "))
+	}
+	if result.UnknownTheme != "" {
+		fmt.Fprintf(os.Stderr, "Unknown theme skipped: %s\n", result.UnknownTheme)
+	}
+
+	fmt.Printf("Profile %s %s: %d leagues and %d favourite teams added\n",
+		data.ActiveProfile(), modeName, result.AddedLeagues, result.AddedTeams)
+	return nil
+}
+
+func init() {
+	settingsImportCmd.Flags().BoolVar(&importReplace, "replace", false, "Replace the profile's settings instead of merging")
+	settingsImportCmd.Flags().BoolVar(&importKeepUnknown, "keep-unknown", false, "Import league IDs missing from the league catalogue")
+	settingsCmd.AddCommand(settingsExportCmd, settingsImportCmd)
+	rootCmd.AddCommand(settingsCmd)
+}
@@ -22,5 +22,23 @@ type Client interface {
 
 	// LeagueTable retrieves the league table/standings for a specific league.
 	// leagueName is used to detect parent leagues for knockout competitions.
-	LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]LeagueTableEntry, error)
+	// Returns one group per table, e.g. each group of a group-stage competition.
+	LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]LeagueTableGroup, error)
+
+	// PlayerProfile retrieves a player's profile and current season statistics.
+	PlayerProfile(ctx context.Context, playerID int) (*PlayerProfile, error)
+
+	// SearchPlayers finds players whose name matches the query.
+	SearchPlayers(ctx context.Context, query string) ([]PlayerSearchResult, error)
+
+	// TeamDetails retrieves a team's overview: league position, form, fixtures and squad.
+	TeamDetails(ctx context.Context, teamID int) (*TeamDetails, error)
+
+	// LeagueStats retrieves a league's top scorers, assist providers and clean sheet leaders.
+	// leagueName is used to detect parent leagues, as for LeagueTable.
+	LeagueStats(ctx context.Context, leagueID int, leagueName string) (*LeagueStats, error)
+
+	// KnockoutBracket retrieves the knockout rounds of a cup competition.
+	// leagueName is used to detect parent leagues, as for LeagueTable.
+	KnockoutBracket(ctx context.Context, leagueID int, leagueName string) (*KnockoutBracket, error)
 }
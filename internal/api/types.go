@@ -46,15 +46,61 @@ type Match struct {
 
 // MatchEvent represents an event in a match (goal, card, substitution, etc.)
 type MatchEvent struct {
-	ID            int       `json:"id"`
-	Minute        int       `json:"minute"`                   // Base minute (e.g., 45)
-	DisplayMinute string    `json:"display_minute,omitempty"` // Formatted minute with stoppage time (e.g., "45+2'")
-	Type          string    `json:"type"`                     // "goal", "card", "substitution", etc.
-	Team          Team      `json:"team"`
-	Player        *string   `json:"player,omitempty"`
-	Assist        *string   `json:"assist,omitempty"`
-	EventType     *string   `json:"event_type,omitempty"` // "yellow", "red", "in", "out", etc.
-	Timestamp     time.Time `json:"timestamp"`
+	ID            int          `json:"id"`
+	Minute        int          `json:"minute"`                   // Base minute (e.g., 45)
+	DisplayMinute string       `json:"display_minute,omitempty"` // Formatted minute with stoppage time (e.g., "45+2'")
+	Kind          EventKind    `json:"kind"`
+	Team          Team         `json:"team"`
+	Player        *string      `json:"player,omitempty"`    // Scorer, booked player or penalty taker
+	PlayerID      int          `json:"player_id,omitempty"` // FotMob player ID of Player, 0 if unknown
+	Assist        *string      `json:"assist,omitempty"`    // Goal assist provider
+	IsPenalty     bool         `json:"is_penalty,omitempty"`
+	IsOwnGoal     bool         `json:"is_own_goal,omitempty"`
+	Card          CardColor    `json:"card,omitempty"`       // Card events only
+	PlayerIn      *EventPlayer `json:"player_in,omitempty"`  // Substitution events only
+	PlayerOut     *EventPlayer `json:"player_out,omitempty"` // Substitution events only
+	Decision      *string      `json:"decision,omitempty"`   // VAR decision or reason (e.g., "Goal cancelled", "Offside")
+	Detail        string       `json:"detail,omitempty"`     // Free text for added time and unrecognised events
+	Timestamp     time.Time    `json:"timestamp"`
+}
+
+// EventKind identifies the kind of a match event.
+type EventKind string
+
+const (
+	EventKindGoal           EventKind = "goal"
+	EventKindDisallowedGoal EventKind = "disallowed_goal"
+	EventKindMissedPenalty  EventKind = "missed_penalty"
+	EventKindVAR            EventKind = "var"
+	EventKindCard           EventKind = "card"
+	EventKindSubstitution   EventKind = "substitution"
+	EventKindAddedTime      EventKind = "added_time"
+	EventKindOther          EventKind = "other"
+)
+
+// CardColor is the colour of a booking.
+type CardColor string
+
+const (
+	CardYellow       CardColor = "yellow"
+	CardSecondYellow CardColor = "second_yellow"
+	CardRed          CardColor = "red"
+)
+
+// IsRed reports whether the card sends the player off (straight red or second yellow).
+func (c CardColor) IsRed() bool {
+	return c == CardRed || c == CardSecondYellow
+}
+
+// EventPlayer identifies a player involved in an event.
+type EventPlayer struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// IsGoal reports whether the event counts towards the score (including own goals).
+func (e MatchEvent) IsGoal() bool {
+	return e.Kind == EventKindGoal
 }
 
 // MatchStatistic represents a single match statistic (possession, shots, etc.)
@@ -72,6 +118,20 @@ type PlayerInfo struct {
 	Number   int    `json:"number,omitempty"`
 	Position string `json:"position,omitempty"`
 	Rating   string `json:"rating,omitempty"` // Player rating (e.g., "7.2")
+
+	// Performance holds the player's match statistics (nil if unavailable)
+	Performance *PlayerPerformance `json:"performance,omitempty"`
+}
+
+// PlayerPerformance represents a player's statistics for a single match
+type PlayerPerformance struct {
+	MinutesPlayed  int    `json:"minutes_played"`
+	Goals          int    `json:"goals"`
+	Assists        int    `json:"assists"`
+	Shots          int    `json:"shots"`
+	AccuratePasses int    `json:"accurate_passes"`
+	Passes         int    `json:"passes"`
+	FantasyScore   string `json:"fantasy_score,omitempty"` // FotMob fantasy points (e.g., "8")
 }
 
 // MatchDetails contains detailed information about a match
@@ -94,6 +154,7 @@ type MatchDetails struct {
 		Home *int `json:"home,omitempty"`
 		Away *int `json:"away,omitempty"`
 	} `json:"penalties,omitempty"`
+	Shootout []PenaltyKick `json:"shootout,omitempty"` // Penalty shootout kicks in order taken
 
 	// Extended statistics
 	Statistics []MatchStatistic `json:"statistics,omitempty"` // Match statistics (possession, shots, etc.)
@@ -115,13 +176,82 @@ type MatchDetails struct {
 	AwaySubstitutes []PlayerInfo `json:"away_substitutes,omitempty"`
 
 	// Momentum/xG data (if available)
-	HomeXG *float64 `json:"home_xg,omitempty"` // Expected goals for home team
-	AwayXG *float64 `json:"away_xg,omitempty"` // Expected goals for away team
+	HomeXG   *float64        `json:"home_xg,omitempty"`  // Expected goals for home team
+	AwayXG   *float64        `json:"away_xg,omitempty"`  // Expected goals for away team
+	Momentum []MomentumPoint `json:"momentum,omitempty"` // Per-minute momentum series
+	Shots    []Shot          `json:"shots,omitempty"`    // All shot attempts in chronological order
+
+	// Previous meetings between the two teams (if available)
+	HeadToHead *HeadToHead `json:"head_to_head,omitempty"`
 
 	// Highlight video (if available)
 	Highlight *MatchHighlight `json:"highlight,omitempty"` // Official highlight video link
 }
 
+// PenaltyKick represents a single kick in a penalty shootout.
+type PenaltyKick struct {
+	Order     int    `json:"order"` // 1-based position in the shootout
+	IsHome    bool   `json:"is_home"`
+	Player    string `json:"player"`
+	PlayerID  int    `json:"player_id,omitempty"`
+	Scored    bool   `json:"scored"`
+	HomeScore int    `json:"home_score"` // Running shootout score after this kick
+	AwayScore int    `json:"away_score"`
+}
+
+// MomentumPoint is one sample of the match momentum series.
+// Positive values favour the home team, negative values the away team (range -100..100).
+type MomentumPoint struct {
+	Minute float64 `json:"minute"`
+	Value  float64 `json:"value"`
+}
+
+// ShotOutcome describes how a shot ended.
+type ShotOutcome string
+
+const (
+	ShotGoal     ShotOutcome = "goal"
+	ShotOnTarget ShotOutcome = "on_target" // Saved
+	ShotBlocked  ShotOutcome = "blocked"
+	ShotOff      ShotOutcome = "off_target" // Wide, over or hit the woodwork
+)
+
+// Shot represents a single shot attempt with its expected goals (xG) value.
+type Shot struct {
+	ID          int         `json:"id"`
+	Minute      int         `json:"minute"`
+	MinuteAdded int         `json:"minute_added,omitempty"` // Stoppage time minutes
+	IsHome      bool        `json:"is_home"`
+	Player      string      `json:"player"`
+	PlayerID    int         `json:"player_id,omitempty"`
+	Outcome     ShotOutcome `json:"outcome"`
+	XG          float64     `json:"xg"`
+	XGOT        *float64    `json:"xgot,omitempty"`      // Expected goals on target, on-target shots only
+	Situation   string      `json:"situation,omitempty"` // e.g. "RegularPlay", "SetPiece", "Penalty"
+	ShotType    string      `json:"shot_type,omitempty"` // e.g. "RightFoot", "Header"
+	IsOwnGoal   bool        `json:"is_own_goal,omitempty"`
+	// Pitch coordinates in metres, normalized so the shot is taken towards the goal at X=105.
+	// X runs along the pitch (0-105), Y across it (0-68).
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// Pitch dimensions in metres used by Shot coordinates.
+const (
+	PitchLength = 105.0
+	PitchWidth  = 68.0
+)
+
+// HeadToHead contains the history of meetings between a match's two teams
+type HeadToHead struct {
+	// Summary of all meetings from the perspective of the current match's teams
+	HomeWins int `json:"home_wins"`
+	Draws    int `json:"draws"`
+	AwayWins int `json:"away_wins"`
+	// Matches are the previous meetings, most recent first
+	Matches []Match `json:"matches,omitempty"`
+}
+
 // MatchHighlight represents an official highlight video for a match
 type MatchHighlight struct {
 	URL    string `json:"url"`              // Direct link to highlight video
@@ -132,14 +262,116 @@ type MatchHighlight struct {
 
 // LeagueTableEntry represents a team's position in the league table
 type LeagueTableEntry struct {
-	Position       int  `json:"position"`
-	Team           Team `json:"team"`
-	Played         int  `json:"played"`
-	Won            int  `json:"won"`
-	Drawn          int  `json:"drawn"`
-	Lost           int  `json:"lost"`
-	GoalsFor       int  `json:"goals_for"`
-	GoalsAgainst   int  `json:"goals_against"`
-	GoalDifference int  `json:"goal_difference"`
-	Points         int  `json:"points"`
+	Position       int    `json:"position"`
+	Team           Team   `json:"team"`
+	Played         int    `json:"played"`
+	Won            int    `json:"won"`
+	Drawn          int    `json:"drawn"`
+	Lost           int    `json:"lost"`
+	GoalsFor       int    `json:"goals_for"`
+	GoalsAgainst   int    `json:"goals_against"`
+	GoalDifference int    `json:"goal_difference"`
+	Points         int    `json:"points"`
+	Form           string `json:"form,omitempty"`       // Recent results oldest first, e.g., "WWDLW"
+	Zone           string `json:"zone,omitempty"`       // Qualification or relegation zone, e.g., "Champions League"
+	ZoneColor      string `json:"zone_color,omitempty"` // Hex colour of the zone, e.g., "#2AD572"
+}
+
+// LeagueTableGroup is one standings table of a league, with overall, home and away rankings.
+// Regular leagues have a single group; group-stage competitions have one per group.
+type LeagueTableGroup struct {
+	Name string             `json:"name"` // e.g., "Premier League" or "Grp. A"
+	All  []LeagueTableEntry `json:"all"`
+	Home []LeagueTableEntry `json:"home,omitempty"`
+	Away []LeagueTableEntry `json:"away,omitempty"`
+}
+
+// PlayerProfile contains a player's profile and current season statistics
+type PlayerProfile struct {
+	ID          int               `json:"id"`
+	Name        string            `json:"name"`
+	Team        Team              `json:"team"`                   // Current club
+	Position    string            `json:"position,omitempty"`     // e.g., "Midfielder"
+	Country     string            `json:"country,omitempty"`      // Nationality
+	Age         int               `json:"age,omitempty"`          // 0 if unknown
+	ShirtNumber int               `json:"shirt_number,omitempty"` // 0 if unknown
+	Season      PlayerSeasonStats `json:"season"`
+}
+
+// PlayerSeasonStats represents a player's statistics in their main league for the current season
+type PlayerSeasonStats struct {
+	League      string `json:"league,omitempty"`
+	Season      string `json:"season,omitempty"` // e.g., "2025/2026"
+	Appearances int    `json:"appearances"`
+	Goals       int    `json:"goals"`
+	Assists     int    `json:"assists"`
+	Rating      string `json:"rating,omitempty"` // Average rating (e.g., "7.12")
+}
+
+// PlayerSearchResult represents a player matching a search query
+type PlayerSearchResult struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	TeamName string `json:"team_name,omitempty"`
+}
+
+// LeagueStats contains a league's season stat leaders, best first
+type LeagueStats struct {
+	Scorers     []StatLeader `json:"scorers"`
+	Assists     []StatLeader `json:"assists"`
+	CleanSheets []StatLeader `json:"clean_sheets"`
+}
+
+// StatLeader is a player's position in a league stat ranking
+type StatLeader struct {
+	Rank     int    `json:"rank"`
+	PlayerID int    `json:"player_id"`
+	Name     string `json:"name"`
+	Team     string `json:"team"`
+	Value    int    `json:"value"` // Goals, assists or clean sheets
+}
+
+// KnockoutBracket contains the knockout rounds of a cup competition, earliest round first
+type KnockoutBracket struct {
+	Rounds []KnockoutRound `json:"rounds"`
+}
+
+// KnockoutRound is a single knockout stage, e.g., "Quarter-finals"
+type KnockoutRound struct {
+	Name string        `json:"name"`
+	Ties []KnockoutTie `json:"ties"` // In bracket order: ties 2i and 2i+1 feed tie i of the next round
+}
+
+// KnockoutTie is a pairing between two teams, played over one or two legs
+type KnockoutTie struct {
+	HomeTeam      Team    `json:"home_team"` // Team drawn first; may be empty while undecided
+	AwayTeam      Team    `json:"away_team"`
+	Legs          []Match `json:"legs,omitempty"`
+	HomeAggregate *int    `json:"home_aggregate,omitempty"` // Nil until a leg has been played
+	AwayAggregate *int    `json:"away_aggregate,omitempty"`
+	HomePenalties *int    `json:"home_penalties,omitempty"` // Set if the tie went to a shootout
+	AwayPenalties *int    `json:"away_penalties,omitempty"`
+	WinnerID      int     `json:"winner_id,omitempty"` // 0 until the tie is decided
+}
+
+// TeamDetails contains a team's overview: league position, form, fixtures and squad
+type TeamDetails struct {
+	Team           Team          `json:"team"`
+	Country        string        `json:"country,omitempty"`
+	League         string        `json:"league,omitempty"`          // Main league name
+	LeaguePosition int           `json:"league_position,omitempty"` // 0 if unknown
+	Form           string        `json:"form,omitempty"`            // Last five results, oldest first (e.g., "WWDLW")
+	Results        []Match       `json:"results,omitempty"`         // Recent results, most recent first
+	Fixtures       []Match       `json:"fixtures,omitempty"`        // Upcoming fixtures, soonest first
+	Squad          []SquadMember `json:"squad,omitempty"`
+}
+
+// SquadMember represents a player (or coach) in a team's squad
+type SquadMember struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Number   int    `json:"number,omitempty"`
+	Position string `json:"position"` // "Goalkeeper", "Defender", "Midfielder", "Forward" or "Coach"
+	Country  string `json:"country,omitempty"`
+	Age      int    `json:"age,omitempty"`
 }
@@ -12,8 +12,11 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// LiveRefreshInterval is the interval between automatic live matches list refreshes.
-const LiveRefreshInterval = 5 * time.Minute
+// liveRefreshInterval returns the interval between automatic live matches list refreshes.
+// Configured by live.refresh_interval (default 5 minutes).
+func liveRefreshInterval() time.Duration {
+	return data.CurrentConfig().Live.RefreshInterval
+}
 
 // fetchLiveMatches fetches live matches from the API (used for cache check only now).
 // Returns mock data if useMockData is true, otherwise uses real API.
@@ -40,17 +43,20 @@ func fetchLiveMatches(client *fotmob.Client, useMockData bool) tea.Cmd {
 	}
 }
 
-// LiveBatchSize is the number of leagues to fetch concurrently in each batch.
-const LiveBatchSize = 4
+// liveBatchSize returns the number of leagues to fetch concurrently in each batch.
+// Configured by live.batch_size (default 4).
+func liveBatchSize() int {
+	return data.CurrentConfig().Live.BatchSize
+}
 
 // fetchLiveBatchData fetches live matches for a batch of leagues concurrently.
-// batchIndex: 0, 1, 2, ... (each batch fetches LiveBatchSize leagues in parallel)
+// batchIndex: 0, 1, 2, ... (each batch fetches liveBatchSize() leagues in parallel)
 // Results appear after each batch completes, giving progressive updates while being fast.
 func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int) tea.Cmd {
 	return func() tea.Msg {
 		totalLeagues := fotmob.TotalLeagues()
-		startIdx := batchIndex * LiveBatchSize
-		endIdx := startIdx + LiveBatchSize
+		startIdx := batchIndex * liveBatchSize()
+		endIdx := startIdx + liveBatchSize()
 		if endIdx > totalLeagues {
 			endIdx = totalLeagues
 		}
@@ -115,10 +121,10 @@ func fetchLiveBatchData(client *fotmob.Client, useMockData bool, batchIndex int)
 	}
 }
 
-// scheduleLiveRefresh schedules the next live matches refresh after 5 minutes.
+// scheduleLiveRefresh schedules the next live matches refresh after live.refresh_interval.
 // This is used to keep the live matches list current while the user is in the view.
 func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
-	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
+	return tea.Tick(liveRefreshInterval(), func(t time.Time) tea.Msg {
 		if useMockData {
 			return liveRefreshMsg{matches: data.MockLiveMatches()}
 		}
@@ -182,10 +188,10 @@ func fetchMatchDetailsForceRefresh(client *fotmob.Client, matchID int, useMockDa
 	}
 }
 
-// schedulePollTick schedules the next poll after 90 seconds.
+// schedulePollTick schedules the next poll after live.poll_interval (default 90 seconds).
 // When the tick fires, it sends pollTickMsg which triggers the actual API call.
 func schedulePollTick(matchID int) tea.Cmd {
-	return tea.Tick(90*time.Second, func(t time.Time) tea.Msg {
+	return tea.Tick(data.CurrentConfig().Live.PollInterval, func(t time.Time) tea.Msg {
 		return pollTickMsg{matchID: matchID}
 	})
 }
@@ -223,6 +229,40 @@ func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool)
 	}
 }
 
+// fetchGridMatchDetails fetches match details for a tile of the live grid.
+// Refreshes bypass the cache, as poll refreshes of the selected match do.
+func fetchGridMatchDetails(client *fotmob.Client, session, matchID int, useMockData, forceRefresh bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			details, _ := data.MockMatchDetails(matchID)
+			return gridDetailsMsg{session: session, matchID: matchID, details: details}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var details *api.MatchDetails
+		var err error
+		if forceRefresh {
+			details, err = client.MatchDetailsForceRefresh(ctx, matchID)
+		} else {
+			details, err = client.MatchDetails(ctx, matchID)
+		}
+		if err != nil {
+			return gridDetailsMsg{session: session, matchID: matchID}
+		}
+
+		return gridDetailsMsg{session: session, matchID: matchID, details: details}
+	}
+}
+
+// scheduleGridPollTick schedules the next refresh of a live grid tile after live.poll_interval.
+func scheduleGridPollTick(session, matchID, poll int) tea.Cmd {
+	return tea.Tick(data.CurrentConfig().Live.PollInterval, func(t time.Time) tea.Msg {
+		return gridPollTickMsg{session: session, matchID: matchID, poll: poll}
+	})
+}
+
 // fetchStatsDayData fetches stats data for a single day (progressive loading).
 // dayIndex: 0 = today, 1 = yesterday, etc.
 // totalDays: total number of days to fetch (for isLast calculation)
@@ -345,7 +385,7 @@ func fetchGoalLinks(redditClient *reddit.Client, details *api.MatchDetails) tea.
 		// Extract goal events from match details
 		var goals []reddit.GoalInfo
 		for _, event := range details.Events {
-			if event.Type != "goal" {
+			if !event.IsGoal() {
 				continue
 			}
 
@@ -445,4 +485,91 @@ func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, home
 	}
 }
 
+// fetchLeagueStats fetches a league's top scorers, assists and clean sheets.
+// Used to populate the league stats dialog.
+func fetchLeagueStats(client *fotmob.Client, leagueID int, leagueName string) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return leagueStatsMsg{leagueName: leagueName}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		stats, err := client.LeagueStats(ctx, leagueID, leagueName)
+		if err != nil {
+			return leagueStatsMsg{leagueName: leagueName}
+		}
+
+		return leagueStatsMsg{leagueName: leagueName, stats: stats}
+	}
+}
+
+// fetchKnockoutBracket fetches the knockout rounds of a cup competition.
+// Used to populate the bracket dialog.
+func fetchKnockoutBracket(client *fotmob.Client, leagueID int, leagueName string, homeTeamID, awayTeamID int) tea.Cmd {
+	return func() tea.Msg {
+		msg := bracketMsg{leagueName: leagueName, homeTeamID: homeTeamID, awayTeamID: awayTeamID}
+		if client == nil {
+			return msg
+		}
 
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		bracket, err := client.KnockoutBracket(ctx, leagueID, leagueName)
+		if err != nil {
+			return msg
+		}
+
+		msg.bracket = bracket
+		return msg
+	}
+}
+
+// fetchPlayerProfile fetches a player's profile and season statistics.
+// When playerID is 0 the player is resolved by searching for name first.
+func fetchPlayerProfile(client *fotmob.Client, playerID int, name string) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return playerProfileMsg{playerID: playerID, name: name}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if playerID == 0 {
+			results, err := client.SearchPlayers(ctx, name)
+			if err != nil || len(results) == 0 {
+				return playerProfileMsg{name: name}
+			}
+			playerID = results[0].ID
+		}
+
+		profile, err := client.PlayerProfile(ctx, playerID)
+		if err != nil {
+			return playerProfileMsg{playerID: playerID, name: name}
+		}
+
+		return playerProfileMsg{playerID: playerID, name: name, profile: profile}
+	}
+}
+
+// fetchTeamDetails fetches a team's overview: league position, form, fixtures and squad.
+func fetchTeamDetails(client *fotmob.Client, teamID int, name string) tea.Cmd {
+	return func() tea.Msg {
+		if client == nil {
+			return teamDetailsMsg{teamID: teamID, name: name}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		details, err := client.TeamDetails(ctx, teamID)
+		if err != nil {
+			return teamDetailsMsg{teamID: teamID, name: name}
+		}
+
+		return teamDetailsMsg{teamID: teamID, name: name, details: details}
+	}
+}
@@ -0,0 +1,214 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// liveGrid is the live view's grid mode: several live matches shown side by side,
+// each polled on its own. Polling of the selected match is paused meanwhile.
+type liveGrid struct {
+	session  int // Model gridSession when opened; messages of earlier grids are dropped
+	matchIDs []int
+	details  map[int]*api.MatchDetails
+	updates  map[int][]api.MatchEvent // Parsed events, newest first
+	loading  map[int]bool
+	polls    map[int]int // Latest refresh scheduled per match; earlier ticks are dropped
+	focus    int
+}
+
+// openGrid shows the selected match and the next live matches of the list in a grid,
+// up to live.grid_size. A filtered list puts only the matches it shows in the grid.
+func (m model) openGrid() (tea.Model, tea.Cmd) {
+	var ids []int
+	if selected := selectedMatchID(m.liveMatchesList); selected != 0 {
+		ids = append(ids, selected)
+	}
+	for _, item := range m.liveMatchesList.VisibleItems() {
+		if len(ids) == data.CurrentConfig().Live.GridSize {
+			break
+		}
+		if match, ok := item.(ui.MatchListItem); ok && !slices.Contains(ids, match.Match.ID) {
+			ids = append(ids, match.Match.ID)
+		}
+	}
+	if len(ids) < data.MinGridSize {
+		return m, nil
+	}
+
+	m.gridSession++
+	m.grid = &liveGrid{
+		session:  m.gridSession,
+		matchIDs: ids,
+		details:  make(map[int]*api.MatchDetails),
+		updates:  make(map[int][]api.MatchEvent),
+		loading:  make(map[int]bool),
+		polls:    make(map[int]int),
+	}
+	m.polling = false // The grid polls the selected match with the others
+	m.loading = false
+	m.debugLog(fmt.Sprintf("grid: opened with matches %v", ids))
+
+	cmds := make([]tea.Cmd, 0, len(ids))
+	for _, id := range ids {
+		m.grid.loading[id] = true
+		cmds = append(cmds, fetchGridMatchDetails(m.fotmobClient, m.grid.session, id, m.useMockData, false))
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// closeGrid leaves grid mode for the list. With open, the focused match is shown
+// in the details panel, otherwise the match shown before; either resumes polling.
+func (m model) closeGrid(open bool) (tea.Model, tea.Cmd) {
+	matchID := 0
+	if m.matchDetails != nil {
+		matchID = m.matchDetails.ID
+	}
+	if open {
+		matchID = m.grid.matchIDs[m.grid.focus]
+	}
+	m.grid = nil
+
+	if matchID == 0 {
+		return m, nil
+	}
+	for i, item := range m.liveMatchesList.VisibleItems() {
+		if match, ok := item.(ui.MatchListItem); ok && match.Match.ID == matchID {
+			m.liveMatchesList.Select(i)
+			break
+		}
+	}
+	m.selectMatch(matchID)
+	return m.loadMatchDetails(matchID)
+}
+
+// handleGridKeys moves between grid tiles, opens or refreshes the focused match,
+// and closes the grid.
+func (m model) handleGridKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	km := keymap.Current()
+	cols := ui.GridColumns(len(m.grid.matchIDs), m.width)
+
+	switch {
+	case key.Matches(msg, km.Left):
+		m.moveGridFocus(-1)
+	case key.Matches(msg, km.Right):
+		m.moveGridFocus(1)
+	case key.Matches(msg, km.Up):
+		m.moveGridFocus(-cols)
+	case key.Matches(msg, km.Down):
+		m.moveGridFocus(cols)
+	case key.Matches(msg, km.Select):
+		return m.closeGrid(true)
+	case key.Matches(msg, km.Grid):
+		return m.closeGrid(false)
+	case key.Matches(msg, km.Refresh):
+		matchID := m.grid.matchIDs[m.grid.focus]
+		m.grid.loading[matchID] = true
+		return m, fetchGridMatchDetails(m.fotmobClient, m.grid.session, matchID, m.useMockData, true)
+	}
+	return m, nil
+}
+
+// moveGridFocus moves the focused tile by delta, staying on the grid.
+func (m *model) moveGridFocus(delta int) {
+	focus := m.grid.focus + delta
+	if focus >= 0 && focus < len(m.grid.matchIDs) {
+		m.grid.focus = focus
+	}
+}
+
+// handleGridMouse focuses a clicked tile; clicking the focused tile opens its match.
+func (m model) handleGridMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	tile, ok := ui.TileAt(msg.X, msg.Y)
+	if !ok || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	if tile == m.grid.focus {
+		return m.closeGrid(true)
+	}
+	m.grid.focus = tile
+	return m, nil
+}
+
+// handleGridDetails stores the details of a grid tile and schedules its next refresh
+// while the match is live. Goals are notified as for the selected match.
+func (m model) handleGridDetails(msg gridDetailsMsg) (tea.Model, tea.Cmd) {
+	if m.grid == nil || m.grid.session != msg.session {
+		return m, nil
+	}
+	m.grid.loading[msg.matchID] = false
+
+	// A failed refresh keeps the last details and tries again next time
+	details := msg.details
+	if details == nil {
+		m.debugLog(fmt.Sprintf("grid: match %d details unavailable", msg.matchID))
+		details = m.grid.details[msg.matchID]
+		if details == nil || details.Status != api.MatchStatusLive {
+			return m, nil
+		}
+		return m, m.scheduleGridPoll(msg.matchID)
+	}
+
+	m.grid.details[msg.matchID] = details
+	m.grid.updates[msg.matchID] = m.parser.ParseEvents(details.Events)
+	if details.Status != api.MatchStatusLive {
+		return m, nil
+	}
+
+	m.notifyNewGoals(details)
+	cmds := []tea.Cmd{m.scheduleGridPoll(msg.matchID)}
+	for _, event := range details.Events {
+		if event.IsGoal() {
+			cmds = append(cmds, fetchGoalLinks(m.redditClient, details))
+			break
+		}
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// scheduleGridPoll schedules the next refresh of a grid tile, superseding any pending
+// one, so that manual refreshes do not start further polling chains.
+func (m model) scheduleGridPoll(matchID int) tea.Cmd {
+	m.grid.polls[matchID]++
+	return scheduleGridPollTick(m.grid.session, matchID, m.grid.polls[matchID])
+}
+
+// handleGridPollTick refreshes a grid tile, unless its grid has been closed or
+// another refresh has been scheduled since.
+func (m model) handleGridPollTick(msg gridPollTickMsg) (tea.Model, tea.Cmd) {
+	if m.grid == nil || m.grid.session != msg.session || m.currentView != viewLiveMatches {
+		return m, nil
+	}
+	if msg.poll != m.grid.polls[msg.matchID] {
+		return m, nil
+	}
+	m.grid.loading[msg.matchID] = true
+	return m, fetchGridMatchDetails(m.fotmobClient, msg.session, msg.matchID, m.useMockData, true)
+}
+
+// gridTiles returns the grid's matches for rendering.
+func (m model) gridTiles() []ui.GridTile {
+	tiles := make([]ui.GridTile, len(m.grid.matchIDs))
+	for i, id := range m.grid.matchIDs {
+		tiles[i] = ui.GridTile{
+			Match:   api.Match{ID: id},
+			Details: m.grid.details[id],
+			Updates: m.grid.updates[id],
+			Loading: m.grid.loading[id],
+		}
+		for _, match := range m.matches {
+			if match.ID == id {
+				tiles[i].Match = match.Match
+				break
+			}
+		}
+	}
+	return tiles
+}
@@ -2,10 +2,14 @@ package app
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/keymap"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -14,90 +18,96 @@ import (
 // Handles navigation (up/down) and selection (enter) to switch between views.
 // On selection, immediately starts API preloading while showing spinner for 2 seconds.
 func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "j", "down":
+	km := keymap.Current()
+	switch {
+	case key.Matches(msg, km.Down):
 		if m.selected < 2 && !m.mainViewLoading { // 3 menu items: 0, 1, 2
 			m.selected++
 		}
-	case "k", "up":
+	case key.Matches(msg, km.Up):
 		if m.selected > 0 && !m.mainViewLoading {
 			m.selected--
 		}
-	case "enter":
+	case key.Matches(msg, km.Select):
 		if m.mainViewLoading {
 			return m, nil
 		}
+		return m.openMenuItem(m.selected)
+	}
+	return m, nil
+}
 
-		// Handle Settings view separately (no API calls needed)
-		if m.selected == 2 {
-			m.settingsState = ui.NewSettingsState()
-			m.currentView = viewSettings
-			return m, nil
-		}
+// openMenuItem opens a main menu item: 0 for stats, 1 for live matches, 2 for settings.
+func (m model) openMenuItem(selection int) (tea.Model, tea.Cmd) {
+	m.selected = selection
 
-		m.mainViewLoading = true
-		m.pendingSelection = m.selected
+	// Handle Settings view separately (no API calls needed)
+	if m.selected == 2 {
+		m.settingsState = ui.NewSettingsState()
+		m.currentView = viewSettings
+		return m, nil
+	}
 
-		// Clear previous view state
-		m.matches = nil
-		m.upcomingMatches = nil
-		m.matchDetails = nil
-		m.liveUpdates = nil
-		m.lastEvents = nil
-		m.lastHomeScore = 0
-		m.lastAwayScore = 0
-		m.polling = false
-		m.upcomingMatchesList.SetItems([]list.Item{})
-		m.matchDetailsCache = make(map[int]*api.MatchDetails)
+	m.mainViewLoading = true
+	m.pendingSelection = m.selected
 
-		// Start API calls immediately while showing main view spinner
-		cmds := []tea.Cmd{
-			m.spinner.Tick,
-			performMainViewCheck(m.selected),
-		}
+	// Clear previous view state
+	m.matches = nil
+	m.upcomingMatches = nil
+	m.matchDetails = nil
+	m.liveUpdates = nil
+	m.lastEvents = nil
+	m.polling = false
+	m.upcomingMatchesList.SetItems([]list.Item{})
+	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 
-		switch m.selected {
-		case 0: // Stats view - fetch data progressively (day by day)
-			m.statsViewLoading = true
-			m.loading = true
-			m.statsData = nil                          // Clear cached data to force fresh fetch
-			m.statsDaysLoaded = 0                      // Reset progress
-			m.statsTotalDays = fotmob.StatsDataDays    // Set total days to load
-			m.statsMatchesList.SetItems([]list.Item{}) // Clear list
-			cmds = append(cmds, ui.SpinnerTick())
-			// Start fetching day 0 (today) first - results shown immediately when it completes
-			cmds = append(cmds, fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
-		case 1: // Live Matches view - preload live matches progressively (parallel batches)
-			m.liveViewLoading = true
-			m.loading = true
-			m.liveBatchesLoaded = 0
-			totalLeagues := fotmob.TotalLeagues()
-			m.liveTotalBatches = (totalLeagues + LiveBatchSize - 1) / LiveBatchSize // Ceiling division
-			m.liveMatchesBuffer = nil                                               // Clear buffer
-			m.liveMatchesList.SetItems([]list.Item{})
-			cmds = append(cmds, ui.SpinnerTick())
-			// Start fetching batch 0 (4 leagues in parallel) - results shown when batch completes
-			cmds = append(cmds, fetchLiveBatchData(m.fotmobClient, m.useMockData, 0))
-		}
+	// Start API calls immediately while showing main view spinner
+	cmds := []tea.Cmd{
+		m.spinner.Tick,
+		performMainViewCheck(m.selected),
+	}
 
-		return m, tea.Batch(cmds...)
+	switch m.selected {
+	case 0: // Stats view - fetch data progressively (day by day)
+		m.statsViewLoading = true
+		m.loading = true
+		m.statsData = nil                          // Clear cached data to force fresh fetch
+		m.statsDaysLoaded = 0                      // Reset progress
+		m.statsTotalDays = fotmob.StatsDataDays    // Set total days to load
+		m.statsMatchesList.SetItems([]list.Item{}) // Clear list
+		cmds = append(cmds, ui.SpinnerTick())
+		// Start fetching day 0 (today) first - results shown immediately when it completes
+		cmds = append(cmds, fetchStatsDayData(m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+	case 1: // Live Matches view - preload live matches progressively (parallel batches)
+		m.liveViewLoading = true
+		m.loading = true
+		m.liveBatchesLoaded = 0
+		totalLeagues := fotmob.TotalLeagues()
+		m.liveTotalBatches = (totalLeagues + liveBatchSize() - 1) / liveBatchSize() // Ceiling division
+		m.liveMatchesBuffer = nil                                                   // Clear buffer
+		m.liveMatchesList.SetItems([]list.Item{})
+		cmds = append(cmds, ui.SpinnerTick())
+		// Start fetching batch 0 (liveBatchSize() leagues in parallel) - results shown when batch completes
+		cmds = append(cmds, fetchLiveBatchData(m.fotmobClient, m.useMockData, 0))
 	}
-	return m, nil
+
+	return m, tea.Batch(cmds...)
 }
 
 // handleLiveMatchesKeys processes keyboard input for the live matches view.
 // Handles navigation between matches and loading match details on selection.
 // Note: Currently unused as list component handles navigation directly.
 func (m model) handleLiveMatchesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "j", "down":
+	km := keymap.Current()
+	switch {
+	case key.Matches(msg, km.Down):
 		if m.selected < len(m.matches)-1 {
 			m.selected++
 			if m.selected < len(m.matches) {
 				return m.loadMatchDetails(m.matches[m.selected].ID)
 			}
 		}
-	case "k", "up":
+	case key.Matches(msg, km.Up):
 		if m.selected > 0 {
 			m.selected--
 			if m.selected >= 0 && m.selected < len(m.matches) {
@@ -108,40 +118,40 @@ func (m model) handleLiveMatchesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// cycleDateRange returns the configured date range step positions away from current, wrapping around.
+func cycleDateRange(current, step int) int {
+	ranges := data.CurrentConfig().Stats.DateRanges
+	i := slices.Index(ranges, current)
+	if i < 0 {
+		return ranges[0]
+	}
+	return ranges[(i+step+len(ranges))%len(ranges)]
+}
+
 // handleStatsViewKeys processes keyboard input for the stats view.
 // Handles date range navigation (left/right) to change the time period.
 // Uses client-side filtering from cached data - no new API calls needed!
 func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	switch msg.String() {
-	case "l", "right":
-		// Cycle date range forward: 1 -> 3 -> 5 -> 1
-		switch m.statsDateRange {
-		case 1:
-			m.statsDateRange = 3
-		case 3:
-			m.statsDateRange = 5
-		default:
-			m.statsDateRange = 1
-		}
-	case "h", "left":
-		// Cycle date range backward: 1 -> 5 -> 3 -> 1
-		switch m.statsDateRange {
-		case 1:
-			m.statsDateRange = 5
-		case 5:
-			m.statsDateRange = 3
-		default:
-			m.statsDateRange = 1
-		}
-	case "tab":
+	km := keymap.Current()
+	switch {
+	case key.Matches(msg, km.Right):
+		// Cycle date range forward through stats.date_ranges, e.g. 1 -> 3 -> 5 -> 1
+		return m.setStatsDateRange(cycleDateRange(m.statsDateRange, 1))
+	case key.Matches(msg, km.Left):
+		// Cycle date range backward, e.g. 1 -> 5 -> 3 -> 1
+		return m.setStatsDateRange(cycleDateRange(m.statsDateRange, -1))
+	case key.Matches(msg, km.FocusDetails):
 		// Tab = toggle focus between left and right panels
 		m.statsRightPanelFocused = !m.statsRightPanelFocused
 		// Reset scroll position when changing focus (both ways for consistency)
 		m.statsScrollOffset = 0
-		return m, nil
-	default:
-		return m, nil
 	}
+	return m, nil
+}
+
+// setStatsDateRange shows the finished matches of the last days and loads the first one's details.
+func (m model) setStatsDateRange(days int) (tea.Model, tea.Cmd) {
+	m.statsDateRange = days
 
 	// If we have cached stats data, just filter client-side (instant!)
 	if m.statsData != nil {
@@ -176,8 +186,6 @@ func (m model) loadMatchDetails(matchID int) (tea.Model, tea.Cmd) {
 func (m model) loadMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.Model, tea.Cmd) {
 	m.liveUpdates = nil
 	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
 	m.loading = true
 	m.liveViewLoading = true
 	m.polling = false // Reset polling state - this is a new match load, not a poll refresh
@@ -234,19 +242,31 @@ func (m model) handleSettingsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	// Only handle custom keys when NOT filtering
 	if !isFiltering {
-		switch msg.String() {
-		case " ": // Space to toggle selection
+		km := keymap.Current()
+		switch {
+		case key.Matches(msg, km.Toggle): // Toggle the highlighted league
 			m.settingsState.Toggle()
 			return m, nil
-		case "right", "l": // Right arrow or 'l' to next tab
+		case key.Matches(msg, km.Right): // Next region tab
 			m.settingsState.NextRegion()
 			return m, nil
-		case "left", "h": // Left arrow or 'h' to previous tab
+		case key.Matches(msg, km.Left): // Previous region tab
 			m.settingsState.PreviousRegion()
 			return m, nil
-		case "enter":
+		case key.Matches(msg, km.Profile): // Save and switch to the next profile
+			if err := m.settingsState.NextProfile(); err != nil {
+				m.debugLog(fmt.Sprintf("switch profile: %v", err))
+			}
+			return m, nil
+		case key.Matches(msg, km.Theme): // Preview the next theme
+			m.settingsState.NextTheme()
+			return m, nil
+		case key.Matches(msg, km.Select):
 			// Save settings and return to main menu
-			_ = m.settingsState.Save() // Best-effort save
+			if err := m.settingsState.Save(); err != nil { // Best-effort save
+				m.settingsState.RevertTheme()
+			}
+			m.applyThemeStyles()
 			m.settingsState = nil
 			m.currentView = viewMain
 			m.selected = 0
@@ -6,9 +6,9 @@ import (
 	"github.com/0xjuanma/golazo/internal/reddit"
 )
 
-// liveUpdateMsg contains a live update string for match events.
+// liveUpdateMsg contains a single live match event.
 type liveUpdateMsg struct {
-	update string
+	event *api.MatchEvent
 }
 
 // matchDetailsMsg contains match details from API response.
@@ -50,7 +50,7 @@ type statsDayDataMsg struct {
 	upcoming []api.Match // upcoming matches (only for today)
 }
 
-// pollTickMsg is sent when the 90-second poll interval elapses.
+// pollTickMsg is sent when the poll interval (live.poll_interval) elapses.
 // This triggers the actual API call with loading state visible.
 type pollTickMsg struct {
 	matchID int
@@ -60,6 +60,22 @@ type pollTickMsg struct {
 // This allows the "Updating..." spinner to be visible for at least 1 second.
 type pollDisplayCompleteMsg struct{}
 
+// gridDetailsMsg contains match details for a tile of the live grid.
+// session tells apart grids opened one after another (see liveGrid).
+type gridDetailsMsg struct {
+	session int
+	matchID int
+	details *api.MatchDetails
+}
+
+// gridPollTickMsg is sent when a live grid tile is due for a refresh (live.poll_interval).
+// poll numbers the tile's scheduled refreshes; only the latest one is kept.
+type gridPollTickMsg struct {
+	session int
+	matchID int
+	poll    int
+}
+
 // goalLinksMsg contains goal replay links fetched from Reddit.
 // Sent after searching r/soccer for Media posts matching goal events.
 type goalLinksMsg struct {
@@ -72,7 +88,39 @@ type goalLinksMsg struct {
 type standingsMsg struct {
 	leagueID   int
 	leagueName string
-	standings  []api.LeagueTableEntry
+	standings  []api.LeagueTableGroup
 	homeTeamID int
 	awayTeamID int
 }
+
+// leagueStatsMsg contains a league's stat leaders from API response.
+// Used to populate the league stats dialog (stats is nil if the lookup failed).
+type leagueStatsMsg struct {
+	leagueName string
+	stats      *api.LeagueStats
+}
+
+// bracketMsg contains a cup competition's knockout rounds from API response.
+// Used to populate the bracket dialog (bracket is nil if unavailable).
+type bracketMsg struct {
+	leagueName string
+	bracket    *api.KnockoutBracket
+	homeTeamID int
+	awayTeamID int
+}
+
+// playerProfileMsg contains a player's profile and season statistics.
+// Used to populate the player profile dialog (profile is nil if the lookup failed).
+type playerProfileMsg struct {
+	playerID int
+	name     string
+	profile  *api.PlayerProfile
+}
+
+// teamDetailsMsg contains a team's overview from API response.
+// Used to populate the team dialog (details is nil if the lookup failed).
+type teamDetailsMsg struct {
+	teamID  int
+	name    string
+	details *api.TeamDetails
+}
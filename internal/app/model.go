@@ -11,6 +11,7 @@ import (
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/keymap"
 	"github.com/0xjuanma/golazo/internal/notify"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
@@ -49,10 +50,8 @@ type model struct {
 	liveUpcomingMatches []ui.MatchDisplay // Upcoming matches for live view (shown at bottom of left panel)
 	matchDetails        *api.MatchDetails
 	matchDetailsCache   map[int]*api.MatchDetails // Cache to avoid repeated API calls
-	liveUpdates         []string
+	liveUpdates         []api.MatchEvent
 	lastEvents          []api.MatchEvent
-	lastHomeScore       int // Track last known home score for goal notifications
-	lastAwayScore       int // Track last known away score for goal notifications
 
 	// Stats data cache - stores 5 days of data, filtered client-side for Today/3d/5d views
 	statsData *fotmob.StatsData
@@ -79,6 +78,8 @@ type model struct {
 	statsDetailsViewport   viewport.Model // Scrollable viewport for match details in stats view
 	statsRightPanelFocused bool           // Whether right panel is focused for scrolling
 	statsScrollOffset      int            // Manual scroll offset for right panel content
+	grid                   *liveGrid      // Grid mode of the live view, nil when off
+	gridSession            int            // Incremented each time the grid is opened
 
 	// Loading states
 	loading          bool
@@ -94,7 +95,7 @@ type model struct {
 	isDevBuild          bool   // Whether this is a development build
 	newVersionAvailable bool   // Whether a new version of Golazo is available
 	appVersion          string // Current application version string
-	statsDateRange      int    // 1, 3, or 5 days (default: 1)
+	statsDateRange      int    // Days, one of stats.date_ranges (default: stats.default_date_range)
 
 	// Settings view state
 	settingsState *ui.SettingsState
@@ -111,7 +112,13 @@ type model struct {
 	goalLinks map[reddit.GoalLinkKey]*reddit.GoalLink
 
 	// Notifications
-	notifier *notify.DesktopNotifier
+	notifier      *notify.DesktopNotifier
+	notifyHistory *notify.History // Persisted per-match record of notified goals
+
+	// Accessible mode announcement log
+	announcements    []string         // Announced events this session, oldest first
+	announcedMatchID int              // Match whose events announcedEvents holds
+	announcedEvents  []api.MatchEvent // Events already announced or shown when the match was opened
 
 	// Logo animation (main view only)
 	animatedLogo *logo.AnimatedLogo
@@ -153,6 +160,16 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	liveList.Styles.FilterCursor = filterCursorStyle
 	liveList.FilterInput.PromptStyle = filterPromptStyle
 	liveList.FilterInput.Cursor.Style = filterCursorStyle
+	ui.ApplyListKeys(&liveList)
+	if ui.Accessible() {
+		liveList.AdditionalShortHelpKeys = func() []key.Binding {
+			return []key.Binding{keymap.Current().Grid, keymap.Current().Announcements}
+		}
+	} else {
+		liveList.AdditionalShortHelpKeys = func() []key.Binding {
+			return []key.Binding{keymap.Current().Grid}
+		}
+	}
 
 	statsList := list.New([]list.Item{}, delegate, 0, 0)
 	statsList.SetShowTitle(false)
@@ -163,10 +180,9 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	statsList.Styles.FilterCursor = filterCursorStyle
 	statsList.FilterInput.PromptStyle = filterPromptStyle
 	statsList.FilterInput.Cursor.Style = filterCursorStyle
+	ui.ApplyListKeys(&statsList)
 	statsList.AdditionalShortHelpKeys = func() []key.Binding {
-		return []key.Binding{
-			key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "focus")),
-		}
+		return []key.Binding{keymap.Describe(keymap.Current().FocusDetails, "focus")}
 	}
 
 	// Initialize viewport for scrollable match details in stats view
@@ -182,6 +198,7 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	upcomingList.Styles.FilterCursor = filterCursorStyle
 	upcomingList.FilterInput.PromptStyle = filterPromptStyle
 	upcomingList.FilterInput.Cursor.Style = filterCursorStyle
+	ui.ApplyListKeys(&upcomingList)
 
 	// Initialize Reddit client (best-effort, nil if fails)
 	var redditClient *reddit.Client
@@ -204,8 +221,11 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		redditClient, _ = reddit.NewClient()
 	}
 
+	// Initialize notification history (best-effort, nil if fails)
+	notifyHistory, _ := notify.NewHistory()
+
 	// Initialize animated logo for main view
-	animatedLogo := logo.NewAnimatedLogoWithType(appVersion, false, logo.DefaultOpts(), 1200, 1, logo.AnimationWave)
+	animatedLogo := newAnimatedLogo(appVersion)
 
 	return model{
 		currentView:            viewMain,
@@ -220,6 +240,7 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		redditClient:           redditClient,
 		goalLinks:              make(map[reddit.GoalLinkKey]*reddit.GoalLink),
 		notifier:               notify.NewDesktopNotifier(),
+		notifyHistory:          notifyHistory,
 		spinner:                s,
 		randomSpinner:          randomSpinner,
 		statsViewSpinner:       statsViewSpinner,
@@ -230,13 +251,39 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		statsDetailsViewport:   statsDetailsViewport,
 		statsRightPanelFocused: false, // Start with left panel focused
 		statsScrollOffset:      0,     // Start at top
-		statsDateRange:         1,
+		statsDateRange:         data.CurrentConfig().Stats.DefaultDateRange,
 		pendingSelection:       -1,                    // No pending selection
 		dialogOverlay:          ui.NewDialogOverlay(), // Initialize dialog overlay
 		animatedLogo:           animatedLogo,          // Initialize animated logo
 	}
 }
 
+// applyThemeStyles recreates the components that copied their styles from the
+// previous theme when created. Call after ui.ApplyTheme.
+func (m *model) applyThemeStyles() {
+	m.spinner.Style = ui.SpinnerStyle()
+
+	delegate := ui.NewMatchListDelegate()
+	filterCursorStyle, filterPromptStyle := ui.FilterInputStyles()
+	for _, l := range []*list.Model{&m.liveMatchesList, &m.statsMatchesList, &m.upcomingMatchesList} {
+		l.SetDelegate(delegate)
+		l.Styles.FilterCursor = filterCursorStyle
+		l.FilterInput.PromptStyle = filterPromptStyle
+		l.FilterInput.Cursor.Style = filterCursorStyle
+	}
+
+	m.animatedLogo = newAnimatedLogo(m.appVersion)
+}
+
+// newAnimatedLogo creates the main view logo, shown at once in accessible mode.
+func newAnimatedLogo(appVersion string) *logo.AnimatedLogo {
+	animatedLogo := logo.NewAnimatedLogoWithType(appVersion, false, logo.DefaultOpts(), 1200, 1, logo.AnimationWave)
+	if ui.Accessible() {
+		animatedLogo.Skip()
+	}
+	return animatedLogo
+}
+
 // getStatusBannerType returns the appropriate status banner type based on current model state.
 // Priority: Debug > Dev > New Version > None
 func (m model) getStatusBannerType() constants.StatusBannerType {
@@ -260,11 +307,17 @@ func (m model) getScrollableContentLength() int {
 
 	lineCount := 0
 
+	// Count penalty shootout kicks (1 line each + section header)
+	if len(m.matchDetails.Shootout) > 0 {
+		lineCount += 1 + len(m.matchDetails.Shootout)
+	}
+
 	// Count goals (each goal is typically 1 line + section header)
 	if len(m.matchDetails.Events) > 0 {
 		goalCount := 0
 		for _, event := range m.matchDetails.Events {
-			if event.Type == "goal" {
+			switch event.Kind {
+			case api.EventKindGoal, api.EventKindDisallowedGoal, api.EventKindMissedPenalty:
 				goalCount++
 			}
 		}
@@ -277,7 +330,7 @@ func (m model) getScrollableContentLength() int {
 	if len(m.matchDetails.Events) > 0 {
 		cardCount := 0
 		for _, event := range m.matchDetails.Events {
-			if event.Type == "card" {
+			if event.Kind == api.EventKindCard {
 				cardCount++
 			}
 		}
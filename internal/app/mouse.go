@@ -0,0 +1,164 @@
+package app
+
+import (
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleMouse routes mouse presses to the open dialog or the current view.
+// Clickable parts are found with ui zones, recorded when the last frame was rendered.
+func (m model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress {
+		return m, nil
+	}
+
+	// Dialogs scroll with the wheel, as with the arrow keys
+	if m.dialogOverlay != nil && m.dialogOverlay.HasDialogs() {
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyUp})
+		case tea.MouseButtonWheelDown:
+			return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+		}
+		return m, nil
+	}
+
+	// Leave the list alone while a filter is being typed
+	if l := m.activeList(); l != nil && l.FilterState() == list.Filtering {
+		return m, nil
+	}
+
+	switch m.currentView {
+	case viewLiveMatches:
+		return m.handleLiveMatchesMouse(msg)
+	case viewStats:
+		return m.handleStatsMouse(msg)
+	case viewSettings:
+		return m.handleSettingsMouse(msg)
+	}
+	return m, nil
+}
+
+// handleLiveMatchesMouse scrolls the live matches list and selects clicked matches.
+func (m model) handleLiveMatchesMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.grid != nil {
+		return m.handleGridMouse(msg)
+	}
+	if msg.Button == tea.MouseButtonLeft && !ui.InZone(ui.ZoneMatchList, msg.X, msg.Y) {
+		return m, nil
+	}
+	if !moveListWithMouse(&m.liveMatchesList, msg) {
+		return m, nil
+	}
+
+	matchID := selectedMatchID(m.liveMatchesList)
+	if matchID == 0 || (m.matchDetails != nil && m.matchDetails.ID == matchID) {
+		return m, nil
+	}
+	m.selectMatch(matchID)
+	return m.loadMatchDetails(matchID)
+}
+
+// handleStatsMouse handles the stats view: the wheel scrolls the panel under the
+// pointer, a click on the details focuses them, and a click on the date range
+// selector or a match selects it.
+func (m model) handleStatsMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if ui.InZone(ui.ZoneDetails, msg.X, msg.Y) {
+		if m.matchDetails == nil {
+			return m, nil
+		}
+		if !m.statsRightPanelFocused {
+			m.statsRightPanelFocused = true
+			m.statsScrollOffset = 0
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.scrollStatsDetails(-1)
+		case tea.MouseButtonWheelDown:
+			m.scrollStatsDetails(1)
+		}
+		return m, nil
+	}
+
+	if days, ok := ui.DateRangeAt(msg.X, msg.Y); ok && msg.Button == tea.MouseButtonLeft {
+		if days == m.statsDateRange {
+			return m, nil
+		}
+		return m.setStatsDateRange(days)
+	}
+
+	if msg.Button == tea.MouseButtonLeft && !ui.InZone(ui.ZoneMatchList, msg.X, msg.Y) {
+		return m, nil
+	}
+	if !moveListWithMouse(&m.statsMatchesList, msg) {
+		return m, nil
+	}
+	m.statsRightPanelFocused = false
+	m.statsScrollOffset = 0
+
+	matchID := selectedMatchID(m.statsMatchesList)
+	if matchID == 0 || (m.matchDetails != nil && m.matchDetails.ID == matchID) {
+		return m, nil
+	}
+	m.selectMatch(matchID)
+	return m.loadStatsMatchDetails(matchID)
+}
+
+// handleSettingsMouse switches region tabs and moves through the leagues.
+// Clicking the highlighted league toggles it.
+func (m model) handleSettingsMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.settingsState == nil {
+		return m, nil
+	}
+
+	if tab, ok := ui.TabAt(msg.X, msg.Y); ok && msg.Button == tea.MouseButtonLeft {
+		m.settingsState.SelectRegion(tab)
+		return m, nil
+	}
+
+	if index, ok := ui.ItemAt(msg.Y); ok && msg.Button == tea.MouseButtonLeft && index == m.settingsState.List.Index() {
+		m.settingsState.Toggle()
+		return m, nil
+	}
+	moveListWithMouse(&m.settingsState.List, msg)
+	return m, nil
+}
+
+// moveListWithMouse moves a list's cursor with the wheel, or to the clicked item.
+// Reports whether the cursor was moved.
+func moveListWithMouse(l *list.Model, msg tea.MouseMsg) bool {
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		l.CursorUp()
+	case tea.MouseButtonWheelDown:
+		l.CursorDown()
+	case tea.MouseButtonLeft:
+		index, ok := ui.ItemAt(msg.Y)
+		if !ok || index >= len(l.VisibleItems()) {
+			return false
+		}
+		l.Select(index)
+	default:
+		return false
+	}
+	return true
+}
+
+// selectedMatchID returns the ID of the match selected in a match list, or 0.
+func selectedMatchID(l list.Model) int {
+	if item, ok := l.SelectedItem().(ui.MatchListItem); ok {
+		return item.Match.ID
+	}
+	return 0
+}
+
+// selectMatch makes matchID the selected match of the current view.
+func (m *model) selectMatch(matchID int) {
+	for i, match := range m.matches {
+		if match.ID == matchID {
+			m.selected = i
+			break
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/0xjuanma/golazo/internal/theme"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// paletteViews are the views the command palette can jump to, by name.
+var paletteViews = []struct {
+	name, title string
+}{
+	{"live", "Live Matches"},
+	{"stats", "Finished Matches"},
+	{"settings", "Settings"},
+	{"main", "Main Menu"},
+	{"help", "Key Bindings"},
+}
+
+// paletteDialogs are the match dialogs offered by the command palette, by key map action.
+var paletteDialogs = []struct {
+	action, title string
+	binding       func(keymap.KeyMap) key.Binding
+}{
+	{"standings", "Standings", func(k keymap.KeyMap) key.Binding { return k.Standings }},
+	{"league_leaders", "League Leaders", func(k keymap.KeyMap) key.Binding { return k.LeagueLeaders }},
+	{"bracket", "Knockout Bracket", func(k keymap.KeyMap) key.Binding { return k.Bracket }},
+	{"formations", "Formations", func(k keymap.KeyMap) key.Binding { return k.Formations }},
+	{"head_to_head", "Head-to-Head", func(k keymap.KeyMap) key.Binding { return k.HeadToHead }},
+	{"statistics", "Statistics", func(k keymap.KeyMap) key.Binding { return k.Statistics }},
+	{"momentum", "Momentum and xG", func(k keymap.KeyMap) key.Binding { return k.Momentum }},
+	{"shot_map", "Shot Map", func(k keymap.KeyMap) key.Binding { return k.ShotMap }},
+	{"players", "Players", func(k keymap.KeyMap) key.Binding { return k.Players }},
+}
+
+// openPalette opens the command palette. Match IDs can be opened from the match views.
+func (m *model) openPalette() {
+	matchIDs := m.currentView == viewLiveMatches || m.currentView == viewStats
+	m.dialogOverlay.OpenDialog(ui.NewPaletteDialog(m.paletteCommands(), matchIDs))
+}
+
+// paletteCommands lists what the command palette can jump to: views, the current
+// match's dialogs, the matches and teams loaded this session, the favourite teams,
+// every league of the catalogue, and the settings tabs and themes.
+func (m model) paletteCommands() []ui.PaletteCommand {
+	var commands []ui.PaletteCommand
+
+	for _, v := range paletteViews {
+		commands = append(commands, ui.PaletteCommand{Kind: ui.CommandView, Title: v.title, Hint: "view", Name: v.name})
+	}
+
+	if m.matchDetails != nil && (m.currentView == viewLiveMatches || m.currentView == viewStats) {
+		km := keymap.Current()
+		for _, d := range paletteDialogs {
+			hint := "match dialog"
+			if b := d.binding(km); b.Enabled() {
+				hint += " (" + b.Help().Key + ")"
+			}
+			commands = append(commands, ui.PaletteCommand{Kind: ui.CommandDialog, Title: d.title, Hint: hint, Name: d.action})
+		}
+	}
+
+	for _, match := range m.matches {
+		commands = append(commands, ui.PaletteCommand{
+			Kind:  ui.CommandMatch,
+			Title: match.HomeTeam.Name + " vs " + match.AwayTeam.Name,
+			Hint:  "match, " + match.League.Name,
+			ID:    match.ID,
+		})
+	}
+
+	teams := make(map[int]bool)
+	addTeam := func(team api.Team, hint string) {
+		if team.ID == 0 || team.Name == "" || teams[team.ID] {
+			return
+		}
+		teams[team.ID] = true
+		commands = append(commands, ui.PaletteCommand{Kind: ui.CommandTeam, Title: team.Name, Hint: hint, ID: team.ID})
+	}
+	for _, team := range data.FavoriteTeams() {
+		addTeam(api.Team{ID: team.ID, Name: team.Name}, "favourite team")
+	}
+	for _, match := range m.loadedMatches() {
+		addTeam(match.HomeTeam, "team")
+		addTeam(match.AwayTeam, "team")
+	}
+
+	leagues := make(map[int]bool)
+	for _, region := range data.GetAllRegions() {
+		for _, league := range data.Leagues()[region] {
+			if leagues[league.ID] {
+				continue
+			}
+			leagues[league.ID] = true
+			commands = append(commands, ui.PaletteCommand{
+				Kind:  ui.CommandLeague,
+				Title: league.Name,
+				Hint:  "standings, " + league.Country,
+				ID:    league.ID,
+			})
+		}
+	}
+
+	for _, tab := range ui.SettingsTabs() {
+		commands = append(commands, ui.PaletteCommand{Kind: ui.CommandTab, Title: "Leagues: " + tab, Hint: "settings", Name: tab})
+	}
+	for _, name := range theme.Names() {
+		commands = append(commands, ui.PaletteCommand{Kind: ui.CommandTheme, Title: "Theme: " + name, Hint: "settings", Name: name})
+	}
+
+	return commands
+}
+
+// loadedMatches returns every match loaded this session that is still held:
+// the current view's matches, upcoming live matches, the cached stats days and the open match.
+func (m model) loadedMatches() []api.Match {
+	var matches []api.Match
+	for _, match := range m.matches {
+		matches = append(matches, match.Match)
+	}
+	for _, match := range m.liveUpcomingMatches {
+		matches = append(matches, match.Match)
+	}
+	if m.statsData != nil {
+		matches = append(matches, m.statsData.AllFinished...)
+		matches = append(matches, m.statsData.TodayUpcoming...)
+	}
+	if m.matchDetails != nil {
+		matches = append(matches, m.matchDetails.Match)
+	}
+	return matches
+}
+
+// runPaletteCommand runs the command picked in the command palette.
+func (m model) runPaletteCommand(c ui.PaletteCommand) (tea.Model, tea.Cmd) {
+	m.debugLog(fmt.Sprintf("palette: kind=%d title=%q id=%d name=%q", c.Kind, c.Title, c.ID, c.Name))
+
+	switch c.Kind {
+	case ui.CommandView:
+		return m.openPaletteView(c.Name)
+	case ui.CommandDialog:
+		return m.openDetailsDialog(c.Name)
+	case ui.CommandMatch:
+		return m.openMatchByID(c.ID)
+	case ui.CommandTeam:
+		return m, fetchTeamDetails(m.fotmobClient, c.ID, c.Title)
+	case ui.CommandLeague:
+		return m, fetchStandings(m.fotmobClient, c.ID, c.Title, 0, 0)
+	case ui.CommandTab, ui.CommandTheme:
+		if m.currentView != viewSettings {
+			updated, cmd := m.openPaletteView("settings")
+			m = updated.(model)
+			if m.currentView != viewSettings {
+				return m, cmd
+			}
+		}
+		if c.Kind == ui.CommandTab {
+			m.settingsState.SelectRegion(slices.Index(m.settingsState.Regions, c.Name))
+		} else {
+			m.settingsState.PreviewTheme(c.Name)
+		}
+	}
+	return m, nil
+}
+
+// openPaletteView leaves the current view for another, as if going back to the
+// main menu and selecting it there.
+func (m model) openPaletteView(name string) (tea.Model, tea.Cmd) {
+	if name == "help" {
+		m.dialogOverlay.OpenDialog(ui.NewHelpDialog(keymap.Current()))
+		return m, nil
+	}
+
+	selection := map[string]int{"stats": 0, "live": 1, "settings": 2}
+	target := map[string]view{"stats": viewStats, "live": viewLiveMatches, "settings": viewSettings, "main": viewMain}
+	if m.currentView == target[name] || m.mainViewLoading {
+		return m, nil
+	}
+
+	if m.currentView == viewSettings && m.settingsState != nil {
+		// Leaving without saving discards a previewed theme
+		m.settingsState.RevertTheme()
+		m.applyThemeStyles()
+	}
+	updated, _ := m.resetToMainView()
+	m = updated.(model)
+
+	if name == "main" {
+		return m, nil
+	}
+	return m.openMenuItem(selection[name])
+}
+
+// openMatchByID shows a match in the details panel of the current match view,
+// selecting it in the list when it is there.
+func (m model) openMatchByID(matchID int) (tea.Model, tea.Cmd) {
+	var l *list.Model
+	switch m.currentView {
+	case viewLiveMatches:
+		l = &m.liveMatchesList
+		m.grid = nil
+	case viewStats:
+		l = &m.statsMatchesList
+		m.statsRightPanelFocused = false
+		m.statsScrollOffset = 0
+	default:
+		return m, nil
+	}
+
+	l.ResetFilter()
+	for i, item := range l.Items() {
+		if match, ok := item.(ui.MatchListItem); ok && match.Match.ID == matchID {
+			l.Select(i)
+			m.selectMatch(matchID)
+			break
+		}
+	}
+
+	if m.matchDetails != nil && m.matchDetails.ID == matchID {
+		return m, nil
+	}
+	if m.currentView == viewLiveMatches {
+		return m.loadMatchDetails(matchID)
+	}
+	return m.loadStatsMatchDetails(matchID)
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/theme"
+	"github.com/0xjuanma/golazo/internal/ui"
+)
+
+// newTestModel returns a model on mock data, without a FotMob client, whose
+// config and settings live in a temporary directory.
+func newTestModel(t *testing.T) model {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("XDG_CACHE_HOME", dir)
+	if err := data.SetActiveProfile(data.DefaultProfile); err != nil { // Drops the cached profile
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ui.ApplyTheme(theme.Default) })
+
+	m := New(true, false, false, false, "dev")
+	m.fotmobClient = nil
+	return m
+}
+
+func TestPaletteCommands(t *testing.T) {
+	m := newTestModel(t)
+	if err := data.SaveSettings(&data.Settings{FavoriteTeams: []data.FavoriteTeam{{ID: 8456, Name: "Man City"}, {ID: 9825, Name: "Arsenal"}}}); err != nil {
+		t.Fatal(err)
+	}
+	arsenal := api.Team{ID: 9825, Name: "Arsenal"}
+	chelsea := api.Team{ID: 8455, Name: "Chelsea"}
+	match := api.Match{ID: 4506263, HomeTeam: arsenal, AwayTeam: chelsea, League: api.League{ID: 47, Name: "Premier League"}}
+	m.currentView = viewLiveMatches
+	m.matches = []ui.MatchDisplay{{Match: match}}
+	m.matchDetails = &api.MatchDetails{Match: match}
+
+	commands := m.paletteCommands()
+
+	// Commands are grouped by kind, in this order
+	order := []ui.CommandKind{ui.CommandView, ui.CommandDialog, ui.CommandMatch, ui.CommandTeam, ui.CommandLeague, ui.CommandTab, ui.CommandTheme}
+	var kinds []ui.CommandKind
+	for _, c := range commands {
+		if len(kinds) == 0 || kinds[len(kinds)-1] != c.Kind {
+			kinds = append(kinds, c.Kind)
+		}
+	}
+	if !slices.Equal(kinds, order) {
+		t.Errorf("command kinds = %v; want %v", kinds, order)
+	}
+
+	var teams []string
+	for _, c := range commands {
+		if c.Kind == ui.CommandTeam {
+			teams = append(teams, c.Title+" ("+c.Hint+")")
+		}
+	}
+	want := []string{"Man City (favourite team)", "Arsenal (favourite team)", "Chelsea (team)"}
+	if !slices.Equal(teams, want) {
+		t.Errorf("teams = %q; want %q", teams, want)
+	}
+
+	// Match dialogs are only offered with a match open
+	m.matchDetails = nil
+	for _, c := range m.paletteCommands() {
+		if c.Kind == ui.CommandDialog {
+			t.Errorf("paletteCommands() offered %q without a match", c.Title)
+		}
+	}
+}
+
+func TestRunPaletteCommand(t *testing.T) {
+	details := &api.MatchDetails{Match: api.Match{ID: 1, League: api.League{ID: 42, Name: "Champions League"}, HomeTeam: api.Team{ID: 10}, AwayTeam: api.Team{ID: 20}}}
+	regions := data.GetAllRegions()
+
+	tests := []struct {
+		command    ui.PaletteCommand
+		from       view
+		wantView   view
+		wantDialog string // Dialog opened, if any
+		wantMsg    any    // Message of the returned command, if any
+		desc       string
+	}{
+		{ui.PaletteCommand{Kind: ui.CommandView, Name: "settings"}, viewMain, viewSettings, "", nil, "opens a view"},
+		{ui.PaletteCommand{Kind: ui.CommandView, Name: "main"}, viewSettings, viewMain, "", nil, "goes back to the main menu"},
+		{ui.PaletteCommand{Kind: ui.CommandView, Name: "help"}, viewStats, viewStats, "help", nil, "opens the key bindings"},
+		{ui.PaletteCommand{Kind: ui.CommandDialog, Name: "standings"}, viewStats, viewStats, "", standingsMsg{leagueID: 42}, "opens a match dialog"},
+		{ui.PaletteCommand{Kind: ui.CommandTeam, Title: "Arsenal", ID: 9825}, viewMain, viewMain, "", teamDetailsMsg{teamID: 9825, name: "Arsenal"}, "opens a team"},
+		{ui.PaletteCommand{Kind: ui.CommandLeague, Title: "Premier League", ID: 47}, viewMain, viewMain, "", standingsMsg{leagueID: 47}, "opens a league's standings"},
+		{ui.PaletteCommand{Kind: ui.CommandTab, Name: regions[len(regions)-1]}, viewMain, viewSettings, "", nil, "opens a settings tab"},
+		{ui.PaletteCommand{Kind: ui.CommandTheme, Name: theme.Mono}, viewMain, viewSettings, "", nil, "previews a theme"},
+	}
+
+	for _, tt := range tests {
+		m := newTestModel(t)
+		m.currentView = tt.from
+		m.matchDetails = details
+		if tt.from == viewSettings {
+			m.settingsState = ui.NewSettingsState()
+		}
+
+		updated, cmd := m.runPaletteCommand(tt.command)
+		got := updated.(model)
+
+		if got.currentView != tt.wantView {
+			t.Errorf("view = %v; want %v - %s", got.currentView, tt.wantView, tt.desc)
+		}
+		if tt.wantDialog != "" && !got.dialogOverlay.ContainsDialog(tt.wantDialog) {
+			t.Errorf("dialog %q not opened - %s", tt.wantDialog, tt.desc)
+		}
+		if tt.wantMsg != nil {
+			if cmd == nil {
+				t.Errorf("no command; want %+v - %s", tt.wantMsg, tt.desc)
+			} else if msg := cmd(); !reflect.DeepEqual(msg, tt.wantMsg) {
+				t.Errorf("command message = %+v; want %+v - %s", msg, tt.wantMsg, tt.desc)
+			}
+		}
+
+		switch tt.command.Kind {
+		case ui.CommandTab:
+			if s := got.settingsState; s == nil || s.Regions[s.CurrentRegion] != tt.command.Name {
+				t.Errorf("settings tab not selected - %s", tt.desc)
+			}
+		case ui.CommandTheme:
+			if s := got.settingsState; s == nil || s.Theme != tt.command.Name || !s.HasChanges {
+				t.Errorf("theme not previewed - %s", tt.desc)
+			}
+		}
+	}
+}
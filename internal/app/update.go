@@ -10,8 +10,10 @@ import (
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/keymap"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -37,6 +39,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case liveMatchesMsg:
 		return m.handleLiveMatches(msg)
 
@@ -64,6 +69,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case pollDisplayCompleteMsg:
 		return m.handlePollDisplayComplete()
 
+	case gridDetailsMsg:
+		return m.handleGridDetails(msg)
+
+	case gridPollTickMsg:
+		return m.handleGridPollTick(msg)
+
 	case list.FilterMatchesMsg:
 		// Route filter matches message to the appropriate list based on current view
 		return m.handleFilterMatches(msg)
@@ -74,6 +85,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case standingsMsg:
 		return m.handleStandings(msg)
 
+	case leagueStatsMsg:
+		return m.handleLeagueStats(msg)
+
+	case bracketMsg:
+		return m.handleBracket(msg)
+
+	case playerProfileMsg:
+		return m.handlePlayerProfile(msg)
+
+	case teamDetailsMsg:
+		return m.handleTeamDetails(msg)
+
 	default:
 		// Fallback handler for ui.TickMsg type assertion
 		if _, ok := msg.(ui.TickMsg); ok {
@@ -131,7 +154,7 @@ func (m model) handleWindowSize(msg tea.WindowSizeMsg) (tea.Model, tea.Cmd) {
 
 // handleSpinnerTick updates the standard spinner animation.
 func (m model) handleSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
-	if m.loading || m.mainViewLoading {
+	if (m.loading || m.mainViewLoading) && !ui.Accessible() {
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
@@ -141,8 +164,8 @@ func (m model) handleSpinnerTick(msg spinner.TickMsg) (tea.Model, tea.Cmd) {
 
 // handleLiveUpdate processes live match update messages.
 func (m model) handleLiveUpdate(msg liveUpdateMsg) (tea.Model, tea.Cmd) {
-	if msg.update != "" {
-		m.liveUpdates = append(m.liveUpdates, msg.update)
+	if msg.event != nil {
+		m.liveUpdates = append(m.liveUpdates, *msg.event)
 	}
 
 	// Continue polling if match is live
@@ -210,7 +233,7 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	// Check if match has goals and fetch links immediately (main branch approach)
 	hasGoals := false
 	for _, event := range msg.details.Events {
-		if event.Type == "goal" {
+		if event.IsGoal() {
 			hasGoals = true
 			break
 		}
@@ -231,30 +254,19 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 	if m.currentView == viewLiveMatches || m.pendingSelection == 1 {
 		m.liveViewLoading = false
 
-		// Get current scores
-		homeScore := 0
-		awayScore := 0
-		if msg.details.HomeScore != nil {
-			homeScore = *msg.details.HomeScore
-		}
-		if msg.details.AwayScore != nil {
-			awayScore = *msg.details.AwayScore
-		}
-
-		// Detect new goals during poll refresh (not initial load)
-		// Only notify when: polling is active AND we have previous score data
-		hasScoreData := m.lastHomeScore > 0 || m.lastAwayScore > 0 || len(m.lastEvents) > 0
-		if m.polling && hasScoreData {
+		// Detect new and disallowed goals for live matches. The initial load is included,
+		// so goals scored since a match was last followed (e.g. before a restart) are
+		// notified; a match never followed before only has its goals recorded
+		if m.polling || msg.details.Status == api.MatchStatusLive {
 			m.notifyNewGoals(msg.details)
 		}
-
-		// Update tracked scores for next comparison
-		m.lastHomeScore = homeScore
-		m.lastAwayScore = awayScore
+		if ui.Accessible() && msg.details.Status == api.MatchStatusLive {
+			m.announceNewEvents(msg.details)
+		}
 
 		// Parse ALL events to rebuild the live updates list
 		// This ensures proper ordering (descending by minute) and uniqueness
-		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
+		m.liveUpdates = m.parser.ParseEvents(msg.details.Events)
 		m.lastEvents = msg.details.Events
 
 		// Continue polling if match is live
@@ -267,7 +279,7 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 			// Note: if m.polling is true, m.loading stays true until the 1s timer fires
 
 			m.polling = true
-			// Schedule next poll tick (90 seconds from now)
+			// Schedule next poll tick (live.poll_interval from now)
 			cmds = append(cmds, schedulePollTick(msg.details.ID))
 		} else {
 			m.loading = false
@@ -288,38 +300,57 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// If dialog overlay has active dialogs, route messages there first
 	if m.dialogOverlay != nil && m.dialogOverlay.HasDialogs() {
 		action := m.dialogOverlay.Update(msg)
-		if _, ok := action.(ui.DialogActionClose); ok {
+		switch action := action.(type) {
+		case ui.DialogActionClose:
+			m.dialogOverlay.CloseFrontDialog()
+		case ui.DialogActionOpen:
+			m.dialogOverlay.OpenDialog(action.Dialog)
+		case ui.DialogActionPlayerProfile:
+			return m, fetchPlayerProfile(m.fotmobClient, action.PlayerID, action.Name)
+		case ui.DialogActionTeamDetails:
+			return m, fetchTeamDetails(m.fotmobClient, action.TeamID, action.Name)
+		case ui.DialogActionToggleFavorite:
+			m.toggleFavoriteTeam(action.TeamID, action.Name)
+		case ui.DialogActionCommand:
 			m.dialogOverlay.CloseFrontDialog()
+			return m.runPaletteCommand(action.Command)
 		}
 		return m, nil
 	}
 
-	switch msg.String() {
-	case "q", "ctrl+c":
+	km := keymap.Current()
+	switch {
+	case key.Matches(msg, km.Quit):
 		return m, tea.Quit
-	case "esc":
+	case key.Matches(msg, km.Help):
+		// Typed into a filter rather than opening help while filtering
+		if l := m.activeList(); l == nil || l.FilterState() != list.Filtering {
+			m.dialogOverlay.OpenDialog(ui.NewHelpDialog(km))
+			return m, nil
+		}
+	case key.Matches(msg, km.Palette):
+		if l := m.activeList(); l == nil || l.FilterState() != list.Filtering {
+			m.openPalette()
+			return m, nil
+		}
+	case key.Matches(msg, km.Back):
 		// Check if any list is in filtering mode - if so, let the list handle Esc
 		// to cancel the filter instead of navigating back
-		isFiltering := false
-		switch m.currentView {
-		case viewLiveMatches:
-			isFiltering = m.liveMatchesList.FilterState() == list.Filtering ||
-				m.liveMatchesList.FilterState() == list.FilterApplied
-		case viewStats:
-			isFiltering = m.statsMatchesList.FilterState() == list.Filtering ||
-				m.statsMatchesList.FilterState() == list.FilterApplied
-		case viewSettings:
-			if m.settingsState != nil {
-				isFiltering = m.settingsState.List.FilterState() == list.Filtering ||
-					m.settingsState.List.FilterState() == list.FilterApplied
-			}
-		}
-
-		if isFiltering {
+		if l := m.activeList(); l != nil && l.FilterState() != list.Unfiltered && m.grid == nil {
 			// Let the view-specific handler pass Esc to the list to cancel filter
 			break
 		}
 
+		if m.currentView == viewLiveMatches && m.grid != nil {
+			return m.closeGrid(false)
+		}
+
+		if m.currentView == viewSettings && m.settingsState != nil {
+			// Leaving without saving discards a previewed theme
+			m.settingsState.RevertTheme()
+			m.applyThemeStyles()
+		}
+
 		if m.currentView != viewMain {
 			return m.resetToMainView()
 		}
@@ -348,17 +379,40 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
 	m.lastEvents = nil
-	m.lastHomeScore = 0
-	m.lastAwayScore = 0
 	m.loading = false
 	m.polling = false
 	m.matches = nil
 	m.upcomingMatches = nil
+	m.grid = nil
 	return m, nil
 }
 
+// activeList returns the list of the current view, or nil if it has none.
+func (m *model) activeList() *list.Model {
+	switch m.currentView {
+	case viewLiveMatches:
+		return &m.liveMatchesList
+	case viewStats:
+		return &m.statsMatchesList
+	case viewSettings:
+		if m.settingsState != nil {
+			return &m.settingsState.List
+		}
+	}
+	return nil
+}
+
 // handleLiveMatchesSelection handles list navigation in live matches view.
 func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.grid != nil {
+		return m.handleGridKeys(msg)
+	}
+
+	km := keymap.Current()
+	if key.Matches(msg, km.Grid) && m.liveMatchesList.FilterState() != list.Filtering {
+		return m.openGrid()
+	}
+
 	// Capture selected item BEFORE Update (critical for filter mode - selection changes after filter clears)
 	var preUpdateMatchID int
 	if preItem := m.liveMatchesList.SelectedItem(); preItem != nil {
@@ -387,7 +441,7 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Use pre-update selection if it was valid and different from current
 	// This handles the filter case where Enter clears the filter
 	targetMatchID := postUpdateMatchID
-	if msg.String() == "enter" && preUpdateMatchID != 0 {
+	if key.Matches(msg, km.Select) && preUpdateMatchID != 0 {
 		targetMatchID = preUpdateMatchID
 	}
 
@@ -402,8 +456,15 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.loadMatchDetails(targetMatchID)
 	}
 
+	// Open the announcement log (accessible mode)
+	if key.Matches(msg, km.Announcements) && ui.Accessible() && m.liveMatchesList.FilterState() != list.Filtering {
+		logPath, _ := data.AnnouncementsPath()
+		m.dialogOverlay.OpenDialog(ui.NewAnnouncementsDialog(m.announcements, logPath))
+		return m, listCmd
+	}
+
 	// Handle refresh key (r) to force refresh current match
-	if msg.String() == "r" {
+	if key.Matches(msg, km.Refresh) {
 		m.debugLog(fmt.Sprintf("Live matches refresh key pressed - matchDetails is nil: %v", m.matchDetails == nil))
 		if m.matchDetails != nil {
 			m.debugLog(fmt.Sprintf("Forcing refresh for match ID: %d in live matches view", m.matchDetails.ID))
@@ -418,81 +479,57 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // handleStatsSelection handles list navigation and date range changes in stats view.
 func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	km := keymap.Current()
+
 	// Check if list is in filtering mode - if so, let list handle ALL keys
 	isFiltering := m.statsMatchesList.FilterState() == list.Filtering
 
 	// Handle keys based on focus state
 	if m.statsRightPanelFocused && m.matchDetails != nil && m.statsDetailsViewport.Height > 0 {
 		// Right panel focused - handle scrolling keys and dialog triggers
-		switch msg.String() {
-		case "up", "k":
-			// Manual scroll up
-			if m.matchDetails != nil && m.statsScrollOffset > 0 {
-				m.statsScrollOffset--
-			}
+		switch {
+		case key.Matches(msg, km.Up):
+			m.scrollStatsDetails(-1)
 			return m, nil
-		case "down", "j":
-			// Manual scroll down with bounds checking
-			if m.matchDetails != nil && m.statsRightPanelFocused {
-				// Get content dimensions
-				scrollableLines := m.getScrollableContentLength()
-				headerHeight := m.getHeaderContentHeight()
-
-				// Calculate available height for scrolling
-				availableHeight := m.height - 10 // Approximate panel height minus borders/spinner
-				if availableHeight < 10 {
-					availableHeight = 10
-				}
-				scrollableHeight := availableHeight - headerHeight
-				if scrollableHeight < 3 {
-					scrollableHeight = 3
-				}
-
-				// Check if we can scroll down further
-				maxOffset := scrollableLines - scrollableHeight
-				if maxOffset < 0 {
-					maxOffset = 0
-				}
-
-				if m.statsScrollOffset < maxOffset {
-					m.statsScrollOffset++
-				}
-			}
+		case key.Matches(msg, km.Down):
+			m.scrollStatsDetails(1)
 			return m, nil
-		case "tab":
+		case key.Matches(msg, km.FocusDetails):
 			// Tab toggles focus back to left panel
 			m.statsRightPanelFocused = false
 			return m, nil
-		case "f":
-			// Open formations dialog
-			m.openFormationsDialog()
-			return m, nil
-		case "s":
-			// Fetch standings and open dialog
-			if m.matchDetails != nil {
-				return m, fetchStandings(
-					m.fotmobClient,
-					m.matchDetails.League.ID,
-					m.matchDetails.League.Name,
-					m.matchDetails.HomeTeam.ID,
-					m.matchDetails.AwayTeam.ID,
-				)
-			}
-			return m, nil
-		case "x":
-			// Open full statistics dialog
-			m.openStatisticsDialog()
-			return m, nil
+		case key.Matches(msg, km.Standings):
+			return m.openDetailsDialog("standings")
+		case key.Matches(msg, km.LeagueLeaders):
+			return m.openDetailsDialog("league_leaders")
+		case key.Matches(msg, km.Bracket):
+			return m.openDetailsDialog("bracket")
+		case key.Matches(msg, km.Formations):
+			return m.openDetailsDialog("formations")
+		case key.Matches(msg, km.HeadToHead):
+			return m.openDetailsDialog("head_to_head")
+		case key.Matches(msg, km.Statistics):
+			return m.openDetailsDialog("statistics")
+		case key.Matches(msg, km.Momentum):
+			return m.openDetailsDialog("momentum")
+		case key.Matches(msg, km.ShotMap):
+			return m.openDetailsDialog("shot_map")
+		case key.Matches(msg, km.Players):
+			return m.openDetailsDialog("players")
+		case key.Matches(msg, km.HomeTeam):
+			return m.openDetailsDialog("home_team")
+		case key.Matches(msg, km.AwayTeam):
+			return m.openDetailsDialog("away_team")
 		}
 	}
 
 	// Only handle date range navigation when NOT filtering
 	if !isFiltering {
-		if msg.String() == "h" || msg.String() == "left" || msg.String() == "l" || msg.String() == "right" {
+		if key.Matches(msg, km.Left, km.Right) {
 			return m.handleStatsViewKeys(msg)
 		}
 		// Handle tab toggle when not filtering
-		if msg.String() == "tab" {
+		if key.Matches(msg, km.FocusDetails) {
 			return m.handleStatsViewKeys(msg)
 		}
 	}
@@ -526,7 +563,7 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Use pre-update selection if it was valid and different from current
 	// This handles the filter case where Enter clears the filter
 	targetMatchID := postUpdateMatchID
-	if msg.String() == "enter" && preUpdateMatchID != 0 {
+	if key.Matches(msg, km.Select) && preUpdateMatchID != 0 {
 		targetMatchID = preUpdateMatchID
 	}
 
@@ -542,7 +579,7 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	// Handle refresh key (r) to force refresh current match
-	if msg.String() == "r" {
+	if key.Matches(msg, km.Refresh) {
 		m.debugLog(fmt.Sprintf("Refresh key pressed - matchDetails is nil: %v", m.matchDetails == nil))
 		if m.matchDetails != nil {
 			m.debugLog(fmt.Sprintf("Forcing refresh for match ID: %d", m.matchDetails.ID))
@@ -555,6 +592,77 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, listCmd
 }
 
+// openDetailsDialog opens a dialog of the current match, named by its key map action
+// (e.g. "standings"). Dialogs needing more data are fetched first.
+func (m model) openDetailsDialog(action string) (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil {
+		return m, nil
+	}
+
+	switch action {
+	case "standings":
+		return m, fetchStandings(
+			m.fotmobClient,
+			m.matchDetails.League.ID,
+			m.matchDetails.League.Name,
+			m.matchDetails.HomeTeam.ID,
+			m.matchDetails.AwayTeam.ID,
+		)
+	case "league_leaders":
+		return m, fetchLeagueStats(m.fotmobClient, m.matchDetails.League.ID, m.matchDetails.League.Name)
+	case "bracket":
+		return m, fetchKnockoutBracket(
+			m.fotmobClient,
+			m.matchDetails.League.ID,
+			m.matchDetails.League.Name,
+			m.matchDetails.HomeTeam.ID,
+			m.matchDetails.AwayTeam.ID,
+		)
+	case "formations":
+		m.openFormationsDialog()
+	case "head_to_head":
+		m.openHeadToHeadDialog()
+	case "statistics":
+		m.openStatisticsDialog()
+	case "momentum":
+		m.openMomentumDialog()
+	case "shot_map":
+		m.openShotMapDialog()
+	case "players":
+		m.openMatchPlayersDialog()
+	case "home_team":
+		return m, fetchTeamDetails(m.fotmobClient, m.matchDetails.HomeTeam.ID, m.matchDetails.HomeTeam.Name)
+	case "away_team":
+		return m, fetchTeamDetails(m.fotmobClient, m.matchDetails.AwayTeam.ID, m.matchDetails.AwayTeam.Name)
+	}
+	return m, nil
+}
+
+// scrollStatsDetails scrolls the focused stats details panel by delta lines,
+// without going past the end of its content.
+func (m *model) scrollStatsDetails(delta int) {
+	if m.matchDetails == nil || !m.statsRightPanelFocused {
+		return
+	}
+
+	// Get content dimensions
+	scrollableLines := m.getScrollableContentLength()
+	headerHeight := m.getHeaderContentHeight()
+
+	// Calculate available height for scrolling
+	availableHeight := m.height - 10 // Approximate panel height minus borders/spinner
+	if availableHeight < 10 {
+		availableHeight = 10
+	}
+	scrollableHeight := availableHeight - headerHeight
+	if scrollableHeight < 3 {
+		scrollableHeight = 3
+	}
+
+	maxOffset := max(scrollableLines-scrollableHeight, 0)
+	m.statsScrollOffset = max(min(m.statsScrollOffset+delta, maxOffset), 0)
+}
+
 // handleLiveMatches processes live matches API response.
 func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
@@ -876,7 +984,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 }
 
 // applyStatsDateFilter applies the current date range filter to the cached stats data.
-// This enables instant switching between Today/3d/5d views without new API calls.
+// This enables instant switching between the configured ranges (stats.date_ranges) without new API calls.
 // All filtering is done client-side from the cached 5-day data based on match MatchTime.
 func (m *model) applyStatsDateFilter() {
 	if m.statsData == nil {
@@ -884,17 +992,9 @@ func (m *model) applyStatsDateFilter() {
 	}
 
 	// Filter all views from AllFinished based on match's actual MatchTime date
-	var finishedMatches []api.Match
-	switch m.statsDateRange {
-	case 1:
-		// Today only - filter by match date
-		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, 1)
-	case 3:
-		// Last 3 days - filter by match date
-		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, 3)
-	default:
-		// 5 days - use all data
-		finishedMatches = m.statsData.AllFinished
+	finishedMatches := m.statsData.AllFinished
+	if m.statsDateRange < fotmob.StatsDataDays {
+		finishedMatches = filterMatchesByDays(m.statsData.AllFinished, m.statsDateRange)
 	}
 
 	// Convert to display format
@@ -935,6 +1035,10 @@ func filterMatchesByDays(matches []api.Match, days int) []api.Match {
 // handleAnimationTick updates all UI animations: logo reveal and loading spinners.
 // Uses a SINGLE tick chain - all animations share the same 70ms tick rate.
 func (m model) handleAnimationTick(msg ui.TickMsg) (tea.Model, tea.Cmd) {
+	if ui.Accessible() {
+		return m, nil // No animations: end the tick chain
+	}
+
 	// Logo animation (main view, one-time)
 	logoAnimating := false
 	if m.currentView == viewMain && m.animatedLogo != nil && !m.animatedLogo.IsComplete() {
@@ -1031,7 +1135,7 @@ func (m model) handleMainViewCheck(msg mainViewCheckMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// handlePollTick handles the 90-second poll tick.
+// handlePollTick handles the poll tick.
 // Shows "Updating..." spinner for 1s as visual feedback, then fetches data.
 func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 	// Only process if we're still in live view and polling is active
@@ -1089,15 +1193,21 @@ func (m model) handleFilterMatches(msg list.FilterMatchesMsg) (tea.Model, tea.Cm
 	return m, cmd
 }
 
-// notifyNewGoals sends desktop notifications when a goal is scored.
-// Uses score-based detection (more reliable than event ID comparison).
-// Only called during poll refreshes when we have previous score data.
+// notifyNewGoals sends desktop notifications for goals and penalty shootout kicks not yet
+// notified for this match, and for previously notified goals that were cancelled
+// (score went down, e.g. after VAR).
+// Uses the persisted notification history so restarts and match switches
+// neither repeat nor miss notifications.
 func (m *model) notifyNewGoals(details *api.MatchDetails) {
-	if m.notifier == nil || details == nil {
+	if m.notifier == nil || m.notifyHistory == nil || details == nil {
 		return
 	}
 
-	// Get current scores
+	changes, err := m.notifyHistory.Update(details)
+	if err != nil {
+		m.debugLog(fmt.Sprintf("notification history save failed for match %d: %v", details.ID, err))
+	}
+
 	homeScore := 0
 	awayScore := 0
 	if details.HomeScore != nil {
@@ -1107,34 +1217,62 @@ func (m *model) notifyNewGoals(details *api.MatchDetails) {
 		awayScore = *details.AwayScore
 	}
 
-	// Check if score increased (goal scored)
-	homeGoalScored := homeScore > m.lastHomeScore
-	awayGoalScored := awayScore > m.lastAwayScore
+	// History is kept up to date above even when notifications are turned off,
+	// so enabling them later doesn't replay old goals
+	cfg := data.NotificationRules() // Profile rules, or config.yaml's
+	if !cfg.Enabled {
+		return
+	}
 
-	if !homeGoalScored && !awayGoalScored {
+	// Send notifications - errors are silently ignored to not disrupt the app
+	if cfg.GoalDisallowed {
+		for _, goal := range changes.Disallowed {
+			_ = m.notifier.GoalDisallowed(goal, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
+		}
+	}
+	if cfg.Goals {
+		for _, event := range changes.NewGoals {
+			_ = m.notifier.Goal(event, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
+		}
+	}
+	if cfg.PenaltyKicks {
+		for _, kick := range changes.NewKicks {
+			_ = m.notifier.PenaltyKick(kick, details.HomeTeam, details.AwayTeam)
+		}
+	}
+}
+
+// maxAnnouncements is the number of announcements kept for the announcements dialog.
+const maxAnnouncements = 200
+
+// announceNewEvents adds the events that appeared since the previous refresh of a
+// live match to the announcement log (accessible mode). Events already listed when
+// a match is opened are on screen, so only later ones are announced.
+func (m *model) announceNewEvents(details *api.MatchDetails) {
+	if details.ID != m.announcedMatchID {
+		m.announcedMatchID = details.ID
+		m.announcedEvents = details.Events
 		return
 	}
 
-	// Find the most recent goal event to get player details
-	var goalEvent *api.MatchEvent
-	for i := len(details.Events) - 1; i >= 0; i-- {
-		event := details.Events[i]
-		if strings.ToLower(event.Type) == "goal" {
-			// Check if this goal matches the team that scored
-			if homeGoalScored && event.Team.ID == details.HomeTeam.ID {
-				goalEvent = &event
-				break
-			}
-			if awayGoalScored && event.Team.ID == details.AwayTeam.ID {
-				goalEvent = &event
-				break
-			}
+	var lines []string
+	for _, event := range m.parser.NewEvents(m.announcedEvents, details.Events) {
+		if event.Kind == api.EventKindAddedTime {
+			continue
 		}
+		lines = append(lines, ui.EventAnnouncement(event, details))
+	}
+	m.announcedEvents = details.Events
+	if len(lines) == 0 {
+		return
 	}
 
-	if goalEvent != nil {
-		// Send notification - errors are silently ignored to not disrupt the app
-		_ = m.notifier.Goal(*goalEvent, details.HomeTeam, details.AwayTeam, homeScore, awayScore)
+	m.announcements = append(m.announcements, lines...)
+	if len(m.announcements) > maxAnnouncements {
+		m.announcements = m.announcements[len(m.announcements)-maxAnnouncements:]
+	}
+	if err := data.AppendAnnouncements(lines); err != nil {
+		m.debugLog(fmt.Sprintf("announcement log write failed: %v", err))
 	}
 }
 
@@ -1343,13 +1481,39 @@ func (m *model) openFormationsDialog() {
 		m.matchDetails.AwayFormation,
 		m.matchDetails.HomeStarting,
 		m.matchDetails.AwayStarting,
+		m.matchDetails.Events,
 	)
 	m.dialogOverlay.OpenDialog(dialog)
 }
 
+// openHeadToHeadDialog opens the head-to-head dialog for the current match.
+func (m *model) openHeadToHeadDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+
+	// Skip if no head-to-head data available
+	if m.matchDetails.HeadToHead == nil {
+		return
+	}
+
+	// Get team names
+	homeTeam := m.matchDetails.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = m.matchDetails.HomeTeam.Name
+	}
+	awayTeam := m.matchDetails.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = m.matchDetails.AwayTeam.Name
+	}
+
+	dialog := ui.NewHeadToHeadDialog(homeTeam, awayTeam, m.matchDetails.HomeTeam.ID, m.matchDetails.HeadToHead)
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
 // handleStandings processes standings data and opens the standings dialog.
 func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
-	m.debugLog(fmt.Sprintf("handleStandings: received msg with %d standings, leagueID=%d, leagueName=%s",
+	m.debugLog(fmt.Sprintf("handleStandings: received msg with %d tables, leagueID=%d, leagueName=%s",
 		len(msg.standings), msg.leagueID, msg.leagueName))
 
 	if msg.standings == nil || len(msg.standings) == 0 {
@@ -1361,7 +1525,7 @@ func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	m.debugLog(fmt.Sprintf("handleStandings: creating dialog with %d entries", len(msg.standings)))
+	m.debugLog(fmt.Sprintf("handleStandings: creating dialog with %d tables", len(msg.standings)))
 	dialog := ui.NewStandingsDialog(
 		msg.leagueName,
 		msg.standings,
@@ -1374,6 +1538,32 @@ func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleLeagueStats opens the league stats dialog with the fetched leaders.
+func (m model) handleLeagueStats(msg leagueStatsMsg) (tea.Model, tea.Cmd) {
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	if msg.stats == nil {
+		m.debugLog(fmt.Sprintf("handleLeagueStats: no stats for %s", msg.leagueName))
+	}
+
+	m.dialogOverlay.OpenDialog(ui.NewLeagueStatsDialog(msg.leagueName, msg.stats))
+	return m, nil
+}
+
+// handleBracket opens the bracket dialog with the fetched knockout rounds.
+func (m model) handleBracket(msg bracketMsg) (tea.Model, tea.Cmd) {
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	if msg.bracket == nil {
+		m.debugLog(fmt.Sprintf("handleBracket: no knockout rounds for %s", msg.leagueName))
+	}
+
+	m.dialogOverlay.OpenDialog(ui.NewBracketDialog(msg.leagueName, msg.bracket, msg.homeTeamID, msg.awayTeamID))
+	return m, nil
+}
+
 // openStatisticsDialog opens the full statistics dialog for the current match.
 func (m *model) openStatisticsDialog() {
 	if m.matchDetails == nil || m.dialogOverlay == nil {
@@ -1402,3 +1592,117 @@ func (m *model) openStatisticsDialog() {
 	)
 	m.dialogOverlay.OpenDialog(dialog)
 }
+
+// openMomentumDialog opens the momentum and xG dialog for the current match.
+func (m *model) openMomentumDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+
+	// Skip if neither momentum nor shot data is available
+	if len(m.matchDetails.Momentum) == 0 && len(m.matchDetails.Shots) == 0 {
+		return
+	}
+
+	// Get team names
+	homeTeam := m.matchDetails.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = m.matchDetails.HomeTeam.Name
+	}
+	awayTeam := m.matchDetails.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = m.matchDetails.AwayTeam.Name
+	}
+
+	dialog := ui.NewMomentumDialog(
+		homeTeam,
+		awayTeam,
+		m.matchDetails.Momentum,
+		m.matchDetails.Shots,
+		m.matchDetails.HomeXG,
+		m.matchDetails.AwayXG,
+	)
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
+// openShotMapDialog opens the shot map dialog for the current match.
+func (m *model) openShotMapDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+
+	// Skip if no shot data available
+	if len(m.matchDetails.Shots) == 0 {
+		return
+	}
+
+	// Get team names
+	homeTeam := m.matchDetails.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = m.matchDetails.HomeTeam.Name
+	}
+	awayTeam := m.matchDetails.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = m.matchDetails.AwayTeam.Name
+	}
+
+	dialog := ui.NewShotMapDialog(homeTeam, awayTeam, m.matchDetails.Shots)
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
+// openMatchPlayersDialog opens the list of players involved in the current match events.
+func (m *model) openMatchPlayersDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+
+	// Get team names
+	homeTeam := m.matchDetails.HomeTeam.ShortName
+	if homeTeam == "" {
+		homeTeam = m.matchDetails.HomeTeam.Name
+	}
+	awayTeam := m.matchDetails.AwayTeam.ShortName
+	if awayTeam == "" {
+		awayTeam = m.matchDetails.AwayTeam.Name
+	}
+
+	dialog := ui.NewMatchPlayersDialog(homeTeam, awayTeam, m.matchDetails.HomeTeam.ID, m.matchDetails.Events)
+	m.dialogOverlay.OpenDialog(dialog)
+}
+
+// handlePlayerProfile opens the player profile dialog with the fetched profile.
+func (m model) handlePlayerProfile(msg playerProfileMsg) (tea.Model, tea.Cmd) {
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	if msg.profile == nil {
+		m.debugLog(fmt.Sprintf("handlePlayerProfile: no profile for %q (id=%d)", msg.name, msg.playerID))
+	}
+
+	m.dialogOverlay.OpenDialog(ui.NewPlayerProfileDialog(msg.name, msg.profile))
+	return m, nil
+}
+
+// toggleFavoriteTeam stars or unstars a team in the active profile.
+// Match list titles read favourites when rendered, so they update on their own.
+func (m *model) toggleFavoriteTeam(teamID int, name string) {
+	favorite, err := data.ToggleFavoriteTeam(teamID, name)
+	if err != nil {
+		m.debugLog(fmt.Sprintf("toggleFavoriteTeam: %v", err))
+		return
+	}
+	m.debugLog(fmt.Sprintf("toggleFavoriteTeam: %s (id=%d) favourite=%t in profile %s", name, teamID, favorite, data.ActiveProfile()))
+}
+
+// handleTeamDetails opens the team dialog with the fetched overview.
+func (m model) handleTeamDetails(msg teamDetailsMsg) (tea.Model, tea.Cmd) {
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	if msg.details == nil {
+		m.debugLog(fmt.Sprintf("handleTeamDetails: no details for %q (id=%d)", msg.name, msg.teamID))
+	}
+
+	m.dialogOverlay.OpenDialog(ui.NewTeamDialog(msg.name, msg.details))
+	return m, nil
+}
@@ -7,8 +7,13 @@ import (
 	"github.com/0xjuanma/golazo/internal/ui"
 )
 
-// View renders the current application state.
+// View renders the current application state, recording where its clickable parts are.
 func (m model) View() string {
+	return ui.ScanZones(m.render())
+}
+
+// render renders the current view, with ui zone markers around clickable parts.
+func (m model) render() string {
 	// DEBUG: Log that view is being called
 	m.debugLog(fmt.Sprintf("VIEW: View() called, currentView=%v, width=%d, height=%d, matchDetails=%v", m.currentView, m.width, m.height, m.matchDetails != nil))
 	if m.matchDetails != nil {
@@ -25,6 +30,9 @@ func (m model) View() string {
 		return ui.RenderMainMenu(m.width, m.height, m.selected, m.spinner, m.randomSpinner, m.mainViewLoading, m.getStatusBannerType(), m.animatedLogo)
 
 	case viewLiveMatches:
+		if m.grid != nil {
+			return ui.RenderLiveGridView(m.width, m.height, m.gridTiles(), m.grid.focus, m.buildGoalLinksMap(), m.getStatusBannerType())
+		}
 		m.ensureLiveListSize()
 		return ui.RenderMultiPanelViewWithList(
 			m.width, m.height,
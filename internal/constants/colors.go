@@ -1,7 +0,0 @@
-package constants
-
-// Gradient colors for spinner and title (Red & Cyan theme)
-const (
-	GradientStartColor = "#00FFFF" // Bright cyan
-	GradientEndColor   = "#FF0000" // Bright red
-)
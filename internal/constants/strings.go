@@ -18,6 +18,10 @@ const (
 	PanelMatchStatistics   = "Match Statistics"
 	PanelUpdates           = "Updates"
 	PanelLeaguePreferences = "League Preferences"
+	PanelAnnouncements     = "Announcements"
+	PanelKeyBindings       = "Key Bindings"
+	PanelCommandPalette    = "Command Palette"
+	PanelLiveGrid          = "Live Grid"
 )
 
 // Empty state messages
@@ -27,19 +31,9 @@ const (
 	EmptySelectMatch       = "Select a match"
 	EmptyNoUpdates         = "No updates"
 	EmptyNoMatches         = "No matches available"
-)
-
-// Help text
-const (
-	HelpMainMenu           = "↑/↓: navigate  Enter: select  q: quit"
-	HelpMatchesView        = "↑/↓: navigate  r: refresh details  /: filter  Esc: back  q: quit"
-	HelpSettingsView       = "↑/↓: navigate  ←/→: switch tabs  Space: toggle  /: filter  Enter: save  Esc: back"
-	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh details  /: filter  Esc: back"
-	HelpStatsViewUnfocused = "Tab: focus details"
-	HelpStatsViewFocused   = "Tab: unfocus  s: standings  f: formations  x: all statistics  ↑/↓: scroll"
-	HelpStandingsDialog    = "Esc: close"
-	HelpFormationsDialog   = "Tab/←/→: switch team  Esc: close"
-	HelpStatisticsDialog   = "↑/↓: navigate  Esc: close"
+	EmptyNoCommands        = "No matching commands"
+	EmptyBracketNotDrawn   = "Not drawn yet"
+	EmptyNoAnnouncements   = "No new events yet. Events are announced as they happen in the live match being followed."
 )
 
 // Status text
@@ -60,6 +54,12 @@ const (
 const (
 	// NotificationTitleGoal is the title shown in goal notifications.
 	NotificationTitleGoal = "⚽ GOLAZO!"
+	// NotificationTitleGoalDisallowed is the title shown when a notified goal is cancelled.
+	NotificationTitleGoalDisallowed = "🚫 GOAL DISALLOWED"
+	// NotificationTitlePenaltyScored is the title shown when a shootout penalty is scored.
+	NotificationTitlePenaltyScored = "⚽ PENALTY SCORED"
+	// NotificationTitlePenaltyMissed is the title shown when a shootout penalty is missed.
+	NotificationTitlePenaltyMissed = "❌ PENALTY MISSED"
 )
 
 // Stats labels
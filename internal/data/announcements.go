@@ -0,0 +1,49 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// announcementsFileName is the plain-text log of new match events written in
+// accessible mode, one line per event, for screen readers following it with tail -f.
+const announcementsFileName = "announcements.log"
+
+// maxAnnouncementsSize is the size above which the log is moved to announcements.log.1.
+const maxAnnouncementsSize = 512 * 1024
+
+// AnnouncementsPath returns the path to the announcement log.
+func AnnouncementsPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, announcementsFileName), nil
+}
+
+// AppendAnnouncements appends lines to the announcement log, each prefixed with the time.
+func AppendAnnouncements(lines []string) error {
+	path, err := AnnouncementsPath()
+	if err != nil {
+		return err
+	}
+	if info, err := os.Stat(path); err == nil && info.Size() > maxAnnouncementsSize {
+		_ = os.Rename(path, path+".1") // Keep one previous log
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	now := time.Now().Format("15:04")
+	for _, line := range lines {
+		if _, err := fmt.Fprintf(f, "%s %s\n", now, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
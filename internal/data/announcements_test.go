@@ -0,0 +1,49 @@
+package data
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAppendAnnouncements(t *testing.T) {
+	useTempDirs(t)
+	path, err := AnnouncementsPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := AppendAnnouncements([]string{"12': Goal for Arsenal, Kai Havertz", "41': Yellow card for Declan Rice, Arsenal"}); err != nil {
+		t.Fatalf("AppendAnnouncements() error = %v", err)
+	}
+	raw, _ := os.ReadFile(path)
+	lines := strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], " 12': Goal for Arsenal, Kai Havertz") || len(lines[0]) != len("15:04 12': Goal for Arsenal, Kai Havertz") {
+		t.Errorf("log = %q; want one timestamped line per announcement", lines)
+	}
+
+	// A log at the size limit is kept
+	full := strings.Repeat("x", maxAnnouncementsSize)
+	if err := os.WriteFile(path, []byte(full), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := AppendAnnouncements([]string{"60': VAR review"}); err != nil {
+		t.Fatalf("AppendAnnouncements() error = %v", err)
+	}
+	if _, err := os.Stat(path + ".1"); !os.IsNotExist(err) {
+		t.Errorf("log rotated at %d bytes; want it kept", maxAnnouncementsSize)
+	}
+
+	// Above the limit it is moved aside and a new log started
+	if err := AppendAnnouncements([]string{"67': Goal for Arsenal, Bukayo Saka"}); err != nil {
+		t.Fatalf("AppendAnnouncements() error = %v", err)
+	}
+	old, err := os.ReadFile(path + ".1")
+	if err != nil || !strings.HasPrefix(string(old), full) || !strings.HasSuffix(string(old), " 60': VAR review\n") {
+		t.Errorf("previous log = %d bytes, %v; want the full log", len(old), err)
+	}
+	raw, _ = os.ReadFile(path)
+	if !strings.HasSuffix(string(raw), " 67': Goal for Arsenal, Bukayo Saka\n") || strings.Count(string(raw), "\n") != 1 {
+		t.Errorf("log = %q; want only the new announcement", raw)
+	}
+}
@@ -0,0 +1,187 @@
+package data
+
+import (
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	leagueCatalogFileName = "league_catalog.json"
+	leagueCatalogMaxAge   = 7 * 24 * time.Hour
+)
+
+// leagueCatalogSchema is the versioned format of the cached league catalogue.
+var leagueCatalogSchema = FileSchema{
+	Name:       "league catalog",
+	Format:     FormatJSON,
+	Version:    1,
+	Migrations: []Migration{unversioned},
+}
+
+// leagueCatalogCache is the on-disk format of the provider's league catalogue.
+type leagueCatalogCache struct {
+	Version   int             `json:"version"`
+	FetchedAt time.Time       `json:"fetched_at"`
+	Leagues   []catalogLeague `json:"leagues"`
+}
+
+// catalogLeague is a single competition in the cached catalogue.
+type catalogLeague struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
+}
+
+// catalogState caches the league catalogue read from disk, which is looked up
+// by every league listing. Saving a new catalogue clears it.
+var catalogState struct {
+	mu     sync.RWMutex
+	loaded *leagueCatalogCache // nil until loaded
+}
+
+// europeanCountries and americanCountries map provider country names to regions.
+// Competitions from any other country, or international ones, go to RegionGlobal.
+var (
+	europeanCountries = setOf(
+		"Albania", "Andorra", "Armenia", "Austria", "Azerbaijan", "Belarus", "Belgium",
+		"Bosnia and Herzegovina", "Bulgaria", "Croatia", "Cyprus", "Czech Republic", "Czechia",
+		"Denmark", "England", "Estonia", "Europe", "Faroe Islands", "Finland", "France",
+		"Georgia", "Germany", "Gibraltar", "Greece", "Hungary", "Iceland", "Ireland", "Israel",
+		"Italy", "Kazakhstan", "Kosovo", "Latvia", "Liechtenstein", "Lithuania", "Luxembourg",
+		"Malta", "Moldova", "Montenegro", "Netherlands", "North Macedonia", "Northern Ireland",
+		"Norway", "Poland", "Portugal", "Romania", "Russia", "San Marino", "Scotland", "Serbia",
+		"Slovakia", "Slovenia", "Spain", "Sweden", "Switzerland", "Turkey", "Türkiye", "Ukraine",
+		"Wales",
+	)
+	americanCountries = setOf(
+		"Argentina", "Bolivia", "Brazil", "Canada", "Chile", "Colombia", "Costa Rica", "Ecuador",
+		"El Salvador", "Guatemala", "Honduras", "Jamaica", "Mexico", "Nicaragua", "North America",
+		"Panama", "Paraguay", "Peru", "South America", "Trinidad and Tobago", "United States",
+		"Uruguay", "USA", "Venezuela",
+	)
+)
+
+// setOf builds a lookup set from a list of names.
+func setOf(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+	return set
+}
+
+// RegionForCountry returns the settings region of a competition from its country.
+func RegionForCountry(country string) string {
+	switch {
+	case europeanCountries[country]:
+		return RegionEurope
+	case americanCountries[country]:
+		return RegionAmerica
+	default:
+		return RegionGlobal
+	}
+}
+
+// leagueCatalogPath returns the path to the cached league catalogue.
+func leagueCatalogPath() (string, error) {
+	dir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, leagueCatalogFileName), nil
+}
+
+// loadLeagueCatalog returns the cached league catalogue, reading it from disk once.
+// Returns an empty cache if the file doesn't exist; a corrupt file is moved aside.
+func loadLeagueCatalog() leagueCatalogCache {
+	catalogState.mu.RLock()
+	loaded := catalogState.loaded
+	catalogState.mu.RUnlock()
+	if loaded != nil {
+		return *loaded
+	}
+
+	catalogState.mu.Lock()
+	defer catalogState.mu.Unlock()
+	if catalogState.loaded == nil {
+		cache := readLeagueCatalog()
+		catalogState.loaded = &cache
+	}
+	return *catalogState.loaded
+}
+
+// readLeagueCatalog reads the league catalogue file.
+func readLeagueCatalog() leagueCatalogCache {
+	var cache leagueCatalogCache
+	path, err := leagueCatalogPath()
+	if err != nil {
+		return cache
+	}
+	if err := leagueCatalogSchema.Load(path, &cache); err != nil {
+		leagueCatalogSchema.Recover(err)
+		return leagueCatalogCache{}
+	}
+	return cache
+}
+
+// SaveLeagueCatalog caches the provider's league catalogue on disk, replacing the copy in memory.
+func SaveLeagueCatalog(leagues []LeagueInfo) error {
+	path, err := leagueCatalogPath()
+	if err != nil {
+		return err
+	}
+
+	cache := leagueCatalogCache{Version: leagueCatalogSchema.Version, FetchedAt: time.Now()}
+	for _, l := range leagues {
+		cache.Leagues = append(cache.Leagues, catalogLeague{ID: l.ID, Name: l.Name, Country: l.Country})
+	}
+	err = leagueCatalogSchema.Save(path, cache)
+
+	// The next lookup reads the new file
+	catalogState.mu.Lock()
+	catalogState.loaded = nil
+	catalogState.mu.Unlock()
+	return err
+}
+
+// ShouldRefreshLeagueCatalog returns true if the cached catalogue is missing or older than a week.
+func ShouldRefreshLeagueCatalog() bool {
+	return time.Since(loadLeagueCatalog().FetchedAt) > leagueCatalogMaxAge
+}
+
+// Leagues returns every known league by region: the built-in leagues first, in their
+// curated order, followed by the other competitions of the cached provider catalogue
+// sorted by country and name.
+func Leagues() map[string][]LeagueInfo {
+	leagues := make(map[string][]LeagueInfo, len(AllSupportedLeagues))
+	known := make(map[int]bool)
+	for region, builtin := range AllSupportedLeagues {
+		leagues[region] = append([]LeagueInfo(nil), builtin...)
+		for _, l := range builtin {
+			known[l.ID] = true
+		}
+	}
+
+	extra := make(map[string][]LeagueInfo)
+	for _, l := range loadLeagueCatalog().Leagues {
+		if known[l.ID] || l.ID == 0 || l.Name == "" {
+			continue
+		}
+		known[l.ID] = true
+		region := RegionForCountry(l.Country)
+		extra[region] = append(extra[region], LeagueInfo{ID: l.ID, Name: l.Name, Country: l.Country})
+	}
+
+	for region, more := range extra {
+		sort.SliceStable(more, func(i, j int) bool {
+			if more[i].Country != more[j].Country {
+				return more[i].Country < more[j].Country
+			}
+			return more[i].Name < more[j].Name
+		})
+		leagues[region] = append(leagues[region], more...)
+	}
+	return leagues
+}
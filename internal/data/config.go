@@ -1 +1,381 @@
 package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/0xjuanma/golazo/internal/theme"
+	"gopkg.in/yaml.v3"
+)
+
+const configFileName = "config.yaml"
+
+// CurrentConfigVersion is the schema version written to new configuration files.
+const CurrentConfigVersion = 1
+
+// configSchema is the versioned format of config.yaml. The file is hand-edited,
+// so older versions are upgraded in memory only and typos are reported.
+var configSchema = FileSchema{
+	Name:       "config",
+	Format:     FormatYAML,
+	Version:    CurrentConfigVersion,
+	Migrations: []Migration{unversioned},
+	Strict:     true,
+	KeepFile:   true,
+}
+
+// MaxDateRangeDays is the longest stats date range, matching the days fetched for the stats view.
+const MaxDateRangeDays = 5
+
+// Live matches shown side by side in the live view's grid mode.
+const (
+	MinGridSize = 2
+	MaxGridSize = 6
+)
+
+// Config is golazo's configuration, stored in config.yaml in the config directory.
+// Every value has a default, so the file only needs the settings being changed.
+// Values can be overridden with GOLAZO_* environment variables and command-line flags.
+type Config struct {
+	Version       int                `yaml:"version"`
+	Theme         string             `yaml:"theme"`            // Built-in or custom theme name, see theme.Names
+	Themes        []theme.Theme      `yaml:"themes,omitempty"` // Custom themes; colours left out are taken from the default theme
+	Accessible    bool               `yaml:"accessible"`       // Text labels instead of colour-only cues, no animations
+	Mouse         bool               `yaml:"mouse"`            // Click and scroll; off leaves text selection to the terminal
+	Keys          KeysConfig         `yaml:"keys"`
+	Cache         CacheConfig        `yaml:"cache"`
+	Live          LiveConfig         `yaml:"live"`
+	Stats         StatsConfig        `yaml:"stats"`
+	Notifications NotificationConfig `yaml:"notifications"`
+}
+
+// KeysConfig holds the key bindings: a preset and per-action overrides.
+type KeysConfig struct {
+	Preset   string              `yaml:"preset"`             // Built-in preset, see keymap.Presets
+	Bindings map[string][]string `yaml:"bindings,omitempty"` // Action name to keys, replacing the preset's
+}
+
+// CacheConfig holds how long API responses are cached.
+type CacheConfig struct {
+	MatchesTTL      time.Duration `yaml:"matches_ttl"`
+	MatchDetailsTTL time.Duration `yaml:"match_details_ttl"`
+	LiveMatchesTTL  time.Duration `yaml:"live_matches_ttl"`
+}
+
+// LiveConfig holds refresh settings for the live view.
+type LiveConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval"` // Live matches list refresh
+	PollInterval    time.Duration `yaml:"poll_interval"`    // Selected live match refresh
+	BatchSize       int           `yaml:"batch_size"`       // Leagues fetched concurrently
+	GridSize        int           `yaml:"grid_size"`        // Live matches shown side by side in grid mode
+}
+
+// StatsConfig holds the date ranges offered in the stats view.
+type StatsConfig struct {
+	DateRanges       []int `yaml:"date_ranges,flow"` // Days, ascending, e.g. [1, 3, 5]
+	DefaultDateRange int   `yaml:"default_date_range"`
+}
+
+// NotificationConfig holds which desktop notifications are sent.
+type NotificationConfig struct {
+	Enabled        bool `yaml:"enabled"`
+	Goals          bool `yaml:"goals"`
+	GoalDisallowed bool `yaml:"goal_disallowed"`
+	PenaltyKicks   bool `yaml:"penalty_kicks"`
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() *Config {
+	return &Config{
+		Version: CurrentConfigVersion,
+		Theme:   theme.Default,
+		Mouse:   true,
+		Keys:    KeysConfig{Preset: keymap.Default},
+		Cache: CacheConfig{
+			MatchesTTL:      15 * time.Minute, // Matches list cache (stats view uses client-side filtering)
+			MatchDetailsTTL: 5 * time.Minute,  // Details for live matches need fresher data
+			LiveMatchesTTL:  2 * time.Minute,  // Live matches list cache (quick nav doesn't re-fetch)
+		},
+		Live: LiveConfig{
+			RefreshInterval: 5 * time.Minute,
+			PollInterval:    90 * time.Second,
+			BatchSize:       4,
+			GridSize:        4,
+		},
+		Stats: StatsConfig{
+			DateRanges:       []int{1, 3, 5},
+			DefaultDateRange: 1,
+		},
+		Notifications: NotificationConfig{
+			Enabled:        true,
+			Goals:          true,
+			GoalDisallowed: true,
+			PenaltyKicks:   true,
+		},
+	}
+}
+
+// currentConfig is the configuration in effect, set once at startup.
+var currentConfig = DefaultConfig()
+
+// CurrentConfig returns the configuration in effect (defaults until SetCurrentConfig is called).
+func CurrentConfig() *Config {
+	return currentConfig
+}
+
+// SetCurrentConfig sets the configuration in effect. Call once at startup, before the app runs.
+func SetCurrentConfig(cfg *Config) {
+	currentConfig = cfg
+}
+
+// ConfigPath returns the path to the configuration file.
+func ConfigPath() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, configFileName), nil
+}
+
+// LoadConfig reads the configuration file over the defaults.
+// Returns the defaults if the file doesn't exist; unknown keys and invalid values are errors.
+// The result is not validated, so overrides can be applied first.
+func LoadConfig() (*Config, error) {
+	cfg := DefaultConfig()
+
+	path, err := ConfigPath()
+	if err != nil {
+		return cfg, err
+	}
+
+	if err := configSchema.Load(path, cfg); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return DefaultConfig(), nil
+		}
+		return DefaultConfig(), err
+	}
+	return cfg, nil
+}
+
+// WriteDefaultConfig creates the configuration file with the defaults if it doesn't exist.
+func WriteDefaultConfig() (string, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	raw, err := yaml.Marshal(DefaultConfig())
+	if err != nil {
+		return "", err
+	}
+	return path, os.WriteFile(path, raw, 0644)
+}
+
+// Validate checks every value and reports all problems at once.
+func (c *Config) Validate() error {
+	var problems []string
+	add := func(key, format string, args ...any) {
+		problems = append(problems, key+": "+fmt.Sprintf(format, args...))
+	}
+	atLeast := func(key string, d, minimum time.Duration) {
+		if d < minimum {
+			add(key, "must be at least %s (got %s)", minimum, d)
+		}
+	}
+
+	if c.Version < 1 || c.Version > CurrentConfigVersion {
+		add("version", "unsupported version %d (this golazo supports up to %d)", c.Version, CurrentConfigVersion)
+	}
+
+	names := make(map[string]bool)
+	for i, t := range c.Themes {
+		if err := t.Validate(); err != nil {
+			add(fmt.Sprintf("themes[%d]", i), "%v", err)
+		} else if names[t.Name] {
+			add(fmt.Sprintf("themes[%d]", i), "name %q is used by another theme", t.Name)
+		}
+		names[t.Name] = true
+	}
+	if !theme.IsBuiltin(c.Theme) && !names[c.Theme] {
+		available := theme.Names()
+		for _, t := range c.Themes {
+			if !slices.Contains(available, t.Name) {
+				available = append(available, t.Name)
+			}
+		}
+		add("theme", "unknown theme %q (available: %s)", c.Theme, strings.Join(available, ", "))
+	}
+
+	if _, err := c.KeyMap(); err != nil {
+		add("keys", "%v", err)
+	}
+
+	atLeast("cache.matches_ttl", c.Cache.MatchesTTL, time.Second)
+	atLeast("cache.match_details_ttl", c.Cache.MatchDetailsTTL, time.Second)
+	atLeast("cache.live_matches_ttl", c.Cache.LiveMatchesTTL, time.Second)
+
+	// Keep request rates reasonable for the provider
+	atLeast("live.refresh_interval", c.Live.RefreshInterval, 30*time.Second)
+	atLeast("live.poll_interval", c.Live.PollInterval, 30*time.Second)
+	if c.Live.BatchSize < 1 || c.Live.BatchSize > 16 {
+		add("live.batch_size", "must be between 1 and 16 (got %d)", c.Live.BatchSize)
+	}
+	if c.Live.GridSize < MinGridSize || c.Live.GridSize > MaxGridSize {
+		add("live.grid_size", "must be between %d and %d (got %d)", MinGridSize, MaxGridSize, c.Live.GridSize)
+	}
+
+	ranges := c.Stats.DateRanges
+	switch {
+	case len(ranges) == 0:
+		add("stats.date_ranges", "must list at least one range")
+	case !slices.IsSorted(ranges) || len(slices.Compact(slices.Clone(ranges))) != len(ranges):
+		add("stats.date_ranges", "must be in ascending order without duplicates (got %v)", ranges)
+	case ranges[0] < 1 || ranges[len(ranges)-1] > MaxDateRangeDays:
+		add("stats.date_ranges", "days must be between 1 and %d (got %v)", MaxDateRangeDays, ranges)
+	}
+	if !slices.Contains(ranges, c.Stats.DefaultDateRange) {
+		add("stats.default_date_range", "must be one of stats.date_ranges %v (got %d)", ranges, c.Stats.DefaultDateRange)
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid configuration:\n  - %s", strings.Join(problems, "\n  - "))
+}
+
+// KeyMap builds the configured key bindings.
+func (c *Config) KeyMap() (keymap.KeyMap, error) {
+	return keymap.New(c.Keys.Preset, c.Keys.Bindings)
+}
+
+// ConfigOption is a configuration value that can be overridden from the environment
+// or the command line, e.g. "live.batch_size" by GOLAZO_LIVE_BATCH_SIZE or --live-batch-size.
+type ConfigOption struct {
+	Key         string // Dotted path in config.yaml
+	Description string
+	IsBool      bool // The flag may be given without a value, meaning true
+	set         func(c *Config, value string) error
+}
+
+// EnvVar returns the environment variable overriding the option.
+func (o ConfigOption) EnvVar() string {
+	return "GOLAZO_" + strings.ToUpper(strings.NewReplacer(".", "_").Replace(o.Key))
+}
+
+// Flag returns the command-line flag overriding the option, without dashes.
+func (o ConfigOption) Flag() string {
+	return strings.NewReplacer(".", "-", "_", "-").Replace(o.Key)
+}
+
+// Set parses value and stores it in c.
+func (o ConfigOption) Set(c *Config, value string) error {
+	if err := o.set(c, strings.TrimSpace(value)); err != nil {
+		return fmt.Errorf("%s: %w", o.Key, err)
+	}
+	return nil
+}
+
+// stringOption, durationOption, intOption, boolOption and intListOption build options of each value type.
+func stringOption(key, desc string, field func(*Config) *string) ConfigOption {
+	return ConfigOption{Key: key, Description: desc, set: func(c *Config, v string) error {
+		*field(c) = v
+		return nil
+	}}
+}
+
+func durationOption(key, desc string, field func(*Config) *time.Duration) ConfigOption {
+	return ConfigOption{Key: key, Description: desc, set: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q (use e.g. 90s, 5m)", v)
+		}
+		*field(c) = d
+		return nil
+	}}
+}
+
+func intOption(key, desc string, field func(*Config) *int) ConfigOption {
+	return ConfigOption{Key: key, Description: desc, set: func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", v)
+		}
+		*field(c) = n
+		return nil
+	}}
+}
+
+func boolOption(key, desc string, field func(*Config) *bool) ConfigOption {
+	return ConfigOption{Key: key, Description: desc, IsBool: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q (use true or false)", v)
+		}
+		*field(c) = b
+		return nil
+	}}
+}
+
+func intListOption(key, desc string, field func(*Config) *[]int) ConfigOption {
+	return ConfigOption{Key: key, Description: desc, set: func(c *Config, v string) error {
+		var list []int
+		for _, part := range strings.Split(v, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				return fmt.Errorf("invalid list %q (use e.g. 1,3,5)", v)
+			}
+			list = append(list, n)
+		}
+		*field(c) = list
+		return nil
+	}}
+}
+
+// ConfigOptions lists every configuration value, in config.yaml order.
+var ConfigOptions = []ConfigOption{
+	stringOption("theme", "Color theme: "+strings.Join(theme.Names(), ", ")+", or one defined under themes", func(c *Config) *string { return &c.Theme }),
+	boolOption("accessible", "Accessible mode: text labels instead of colour-only cues, no animations and an announcement log of new events", func(c *Config) *bool { return &c.Accessible }),
+	boolOption("mouse", "Mouse support: click matches, tabs and date ranges, and scroll with the wheel", func(c *Config) *bool { return &c.Mouse }),
+	stringOption("keys.preset", "Key binding preset: "+strings.Join(keymap.Presets(), ", "), func(c *Config) *string { return &c.Keys.Preset }),
+	durationOption("cache.matches_ttl", "How long match lists are cached", func(c *Config) *time.Duration { return &c.Cache.MatchesTTL }),
+	durationOption("cache.match_details_ttl", "How long match details are cached", func(c *Config) *time.Duration { return &c.Cache.MatchDetailsTTL }),
+	durationOption("cache.live_matches_ttl", "How long the live matches list is cached", func(c *Config) *time.Duration { return &c.Cache.LiveMatchesTTL }),
+	durationOption("live.refresh_interval", "Interval between live matches list refreshes", func(c *Config) *time.Duration { return &c.Live.RefreshInterval }),
+	durationOption("live.poll_interval", "Interval between refreshes of the selected live match", func(c *Config) *time.Duration { return &c.Live.PollInterval }),
+	intOption("live.batch_size", "Number of leagues fetched concurrently", func(c *Config) *int { return &c.Live.BatchSize }),
+	intOption("live.grid_size", "Number of live matches shown side by side in grid mode", func(c *Config) *int { return &c.Live.GridSize }),
+	intListOption("stats.date_ranges", "Date ranges offered in the stats view, in days (e.g. 1,3,5)", func(c *Config) *[]int { return &c.Stats.DateRanges }),
+	intOption("stats.default_date_range", "Date range selected when opening the stats view", func(c *Config) *int { return &c.Stats.DefaultDateRange }),
+	boolOption("notifications.enabled", "Send desktop notifications", func(c *Config) *bool { return &c.Notifications.Enabled }),
+	boolOption("notifications.goals", "Notify goals", func(c *Config) *bool { return &c.Notifications.Goals }),
+	boolOption("notifications.goal_disallowed", "Notify goals cancelled after being notified", func(c *Config) *bool { return &c.Notifications.GoalDisallowed }),
+	boolOption("notifications.penalty_kicks", "Notify each penalty shootout kick", func(c *Config) *bool { return &c.Notifications.PenaltyKicks }),
+}
+
+// ApplyEnv overrides configuration values from GOLAZO_* environment variables.
+func (c *Config) ApplyEnv() error {
+	for _, opt := range ConfigOptions {
+		if value, ok := os.LookupEnv(opt.EnvVar()); ok {
+			if err := opt.Set(c, value); err != nil {
+				return fmt.Errorf("%s: %w", opt.EnvVar(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// YAML returns the configuration in config.yaml format.
+func (c *Config) YAML() (string, error) {
+	raw, err := yaml.Marshal(c)
+	return string(raw), err
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/theme"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		change  func(c *Config)
+		wantErr string // Substring of the error, empty for valid
+		desc    string
+	}{
+		{func(c *Config) {}, "", "defaults"},
+		{func(c *Config) { c.Version = 0 }, "version:", "version too old"},
+		{func(c *Config) { c.Version = CurrentConfigVersion + 1 }, "version:", "version too new"},
+		{func(c *Config) { c.Theme = "sepia" }, "theme:", "unknown theme"},
+		{func(c *Config) { c.Theme, c.Themes = "sepia", []theme.Theme{{Name: "sepia"}} }, "", "custom theme"},
+		{func(c *Config) { c.Themes = []theme.Theme{{Name: "sepia"}, {Name: "sepia"}} }, "themes[1]:", "duplicate custom theme"},
+		{func(c *Config) { c.Themes = []theme.Theme{{Name: theme.Mono}} }, "themes[0]:", "custom theme named as a built-in one"},
+		{func(c *Config) { c.Keys.Preset = "nano" }, "keys:", "unknown key preset"},
+		{func(c *Config) { c.Keys.Bindings = map[string][]string{"refresh": {"j"}} }, "keys:", "conflicting key"},
+		{func(c *Config) { c.Cache.MatchesTTL = 0 }, "cache.matches_ttl:", "cache TTL too short"},
+		{func(c *Config) { c.Live.PollInterval = 10 * time.Second }, "live.poll_interval:", "poll interval too short"},
+		{func(c *Config) { c.Live.BatchSize = 17 }, "live.batch_size:", "batch size too large"},
+		{func(c *Config) { c.Live.GridSize = MaxGridSize + 1 }, "live.grid_size:", "grid too large"},
+		{func(c *Config) { c.Live.GridSize = MinGridSize }, "", "smallest grid"},
+		{func(c *Config) { c.Stats.DateRanges = nil }, "stats.date_ranges:", "no date ranges"},
+		{func(c *Config) { c.Stats.DateRanges = []int{3, 1} }, "stats.date_ranges:", "date ranges out of order"},
+		{func(c *Config) { c.Stats.DateRanges = []int{1, 1} }, "stats.date_ranges:", "duplicate date ranges"},
+		{func(c *Config) { c.Stats.DateRanges = []int{1, MaxDateRangeDays + 1} }, "stats.date_ranges:", "date range too long"},
+		{func(c *Config) { c.Stats.DefaultDateRange = 2 }, "stats.default_date_range:", "default range not offered"},
+	}
+
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		tt.change(cfg)
+		err := cfg.Validate()
+		switch {
+		case tt.wantErr == "" && err != nil:
+			t.Errorf("Validate() = %v; want nil - %s", err, tt.desc)
+		case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+			t.Errorf("Validate() = %v; want an error for %q - %s", err, tt.wantErr, tt.desc)
+		}
+	}
+
+	// Every problem is reported at once
+	cfg := DefaultConfig()
+	cfg.Cache.MatchesTTL = 0
+	cfg.Live.BatchSize = 0
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "cache.matches_ttl:") || !strings.Contains(err.Error(), "live.batch_size:") {
+		t.Errorf("Validate() = %v; want both problems", err)
+	}
+}
+
+func TestConfigOptionSet(t *testing.T) {
+	option := func(key string) ConfigOption {
+		for _, opt := range ConfigOptions {
+			if opt.Key == key {
+				return opt
+			}
+		}
+		t.Fatalf("no config option %s", key)
+		return ConfigOption{}
+	}
+
+	tests := []struct {
+		key     string
+		value   string
+		check   func(c *Config) bool
+		wantErr bool
+		desc    string
+	}{
+		{"theme", " light ", func(c *Config) bool { return c.Theme == "light" }, false, "string trimmed"},
+		{"live.batch_size", " 8 ", func(c *Config) bool { return c.Live.BatchSize == 8 }, false, "value trimmed"},
+		{"live.batch_size", "eight", nil, true, "not a number"},
+		{"notifications.goals", "false", func(c *Config) bool { return !c.Notifications.Goals }, false, "boolean"},
+		{"notifications.goals", "nope", nil, true, "not a boolean"},
+		{"live.poll_interval", "2m", func(c *Config) bool { return c.Live.PollInterval == 2*time.Minute }, false, "duration"},
+		{"live.poll_interval", "2", nil, true, "duration without unit"},
+		{"live.grid_size", "3", func(c *Config) bool { return c.Live.GridSize == 3 }, false, "number"},
+		{"live.grid_size", "three", nil, true, "not a number"},
+		{"mouse", "false", func(c *Config) bool { return !c.Mouse }, false, "boolean"},
+		{"mouse", "nope", nil, true, "not a boolean"},
+		{"stats.date_ranges", "1, 2,4", func(c *Config) bool {
+			return len(c.Stats.DateRanges) == 3 && c.Stats.DateRanges[1] == 2 && c.Stats.DateRanges[2] == 4
+		}, false, "list"},
+		{"stats.date_ranges", "1;2", nil, true, "malformed list"},
+	}
+
+	for _, tt := range tests {
+		cfg := DefaultConfig()
+		err := option(tt.key).Set(cfg, tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Set(%q) error = %v; want error %v - %s", tt.value, err, tt.wantErr, tt.desc)
+			continue
+		}
+		if err != nil && !strings.HasPrefix(err.Error(), tt.key+": ") {
+			t.Errorf("Set(%q) error = %v; want it prefixed with the key - %s", tt.value, err, tt.desc)
+		}
+		if tt.check != nil && !tt.check(cfg) {
+			t.Errorf("Set(%q) did not store the value - %s", tt.value, tt.desc)
+		}
+	}
+}
+
+func TestConfigOptionNames(t *testing.T) {
+	tests := []struct {
+		key    string
+		envVar string
+		flag   string
+		desc   string
+	}{
+		{"theme", "GOLAZO_THEME", "theme", "top-level"},
+		{"live.batch_size", "GOLAZO_LIVE_BATCH_SIZE", "live-batch-size", "nested"},
+		{"notifications.goal_disallowed", "GOLAZO_NOTIFICATIONS_GOAL_DISALLOWED", "notifications-goal-disallowed", "underscore in the name"},
+	}
+
+	for _, tt := range tests {
+		opt := ConfigOption{Key: tt.key}
+		if got := opt.EnvVar(); got != tt.envVar {
+			t.Errorf("EnvVar() = %s; want %s - %s", got, tt.envVar, tt.desc)
+		}
+		if got := opt.Flag(); got != tt.flag {
+			t.Errorf("Flag() = %s; want %s - %s", got, tt.flag, tt.desc)
+		}
+	}
+}
@@ -57,30 +57,30 @@ func generateLiveMatchEvents(matchID int, match api.Match) []api.MatchEvent {
 
 	case 2001: // Chelsea 2-1 Spurs (67') - Premier League
 		events = []api.MatchEvent{
-			{ID: 1, Minute: 12, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Palmer"), Timestamp: time.Now()},
-			{ID: 2, Minute: 23, Type: "card", Team: match.AwayTeam, Player: stringPtr("Romero"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 3, Minute: 34, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Son"), Assist: stringPtr("Maddison"), Timestamp: time.Now()},
-			{ID: 4, Minute: 45, Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Mudryk"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 5, Minute: 56, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Jackson"), Assist: stringPtr("Palmer"), Timestamp: time.Now()},
-			{ID: 6, Minute: 62, Type: "card", Team: match.HomeTeam, Player: stringPtr("Caicedo"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
+			{ID: 1, Minute: 12, Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Palmer"), Timestamp: time.Now()},
+			{ID: 2, Minute: 23, Kind: api.EventKindCard, Team: match.AwayTeam, Player: stringPtr("Romero"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 3, Minute: 34, Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Son"), Assist: stringPtr("Maddison"), Timestamp: time.Now()},
+			{ID: 4, Minute: 45, Kind: api.EventKindSubstitution, Team: match.HomeTeam, PlayerIn: &api.EventPlayer{Name: "Mudryk"}, PlayerOut: &api.EventPlayer{Name: "Sterling"}, Timestamp: time.Now()},
+			{ID: 5, Minute: 56, Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Jackson"), Assist: stringPtr("Palmer"), Timestamp: time.Now()},
+			{ID: 6, Minute: 62, Kind: api.EventKindCard, Team: match.HomeTeam, Player: stringPtr("Caicedo"), Card: api.CardYellow, Timestamp: time.Now()},
 		}
 
 	case 2002: // Real Madrid 1-1 Atletico (34') - La Liga
 		events = []api.MatchEvent{
-			{ID: 7, Minute: 8, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Griezmann"), Timestamp: time.Now()},
-			{ID: 8, Minute: 18, Type: "card", Team: match.AwayTeam, Player: stringPtr("Savic"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 9, Minute: 28, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Bellingham"), Assist: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
+			{ID: 7, Minute: 8, Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Griezmann"), Timestamp: time.Now()},
+			{ID: 8, Minute: 18, Kind: api.EventKindCard, Team: match.AwayTeam, Player: stringPtr("Savic"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 9, Minute: 28, Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Bellingham"), Assist: stringPtr("Vinicius Jr"), Timestamp: time.Now()},
 		}
 
 	case 2003: // Man City 3-2 Bayern (56') - Champions League
 		events = []api.MatchEvent{
-			{ID: 10, Minute: 5, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Haaland"), Timestamp: time.Now()},
-			{ID: 11, Minute: 15, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Kane"), Assist: stringPtr("Sane"), Timestamp: time.Now()},
-			{ID: 12, Minute: 23, Type: "card", Team: match.HomeTeam, Player: stringPtr("Rodri"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 13, Minute: 34, Type: "goal", Team: match.HomeTeam, Player: stringPtr("De Bruyne"), Timestamp: time.Now()},
-			{ID: 14, Minute: 42, Type: "goal", Team: match.AwayTeam, Player: stringPtr("Musiala"), Timestamp: time.Now()},
-			{ID: 15, Minute: 45, Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Coman"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 16, Minute: 52, Type: "goal", Team: match.HomeTeam, Player: stringPtr("Foden"), Assist: stringPtr("Haaland"), Timestamp: time.Now()},
+			{ID: 10, Minute: 5, Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Haaland"), Timestamp: time.Now()},
+			{ID: 11, Minute: 15, Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Kane"), Assist: stringPtr("Sane"), Timestamp: time.Now()},
+			{ID: 12, Minute: 23, Kind: api.EventKindCard, Team: match.HomeTeam, Player: stringPtr("Rodri"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 13, Minute: 34, Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("De Bruyne"), Timestamp: time.Now()},
+			{ID: 14, Minute: 42, Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Musiala"), Timestamp: time.Now()},
+			{ID: 15, Minute: 45, Kind: api.EventKindSubstitution, Team: match.AwayTeam, PlayerIn: &api.EventPlayer{Name: "Coman"}, PlayerOut: &api.EventPlayer{Name: "Gnabry"}, Timestamp: time.Now()},
+			{ID: 16, Minute: 52, Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Foden"), Assist: stringPtr("Haaland"), Timestamp: time.Now()},
 		}
 
 	// ═══════════════════════════════════════════════
@@ -89,28 +89,28 @@ func generateLiveMatchEvents(matchID int, match api.Match) []api.MatchEvent {
 
 	case 2004: // Arsenal 2-3 Liverpool (FT) - Premier League
 		events = []api.MatchEvent{
-			{ID: 17, Minute: 8, DisplayMinute: "8'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
-			{ID: 18, Minute: 15, DisplayMinute: "15'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Rice"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 19, Minute: 23, DisplayMinute: "23'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
-			{ID: 20, Minute: 34, DisplayMinute: "34'", Type: "substitution", Team: match.AwayTeam, Player: stringPtr("Gakpo"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 21, Minute: 45, DisplayMinute: "45+1'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Nunez"), Timestamp: time.Now()},
-			{ID: 22, Minute: 56, DisplayMinute: "56'", Type: "card", Team: match.AwayTeam, Player: stringPtr("Van Dijk"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 23, Minute: 67, DisplayMinute: "67'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Martinelli"), Timestamp: time.Now()},
-			{ID: 24, Minute: 78, DisplayMinute: "78'", Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Trossard"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 25, Minute: 85, DisplayMinute: "85'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Gabriel"), EventType: stringPtr("red"), Timestamp: time.Now()},
-			{ID: 26, Minute: 90, DisplayMinute: "90+3'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Diaz"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 17, Minute: 8, DisplayMinute: "8'", Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Salah"), Timestamp: time.Now()},
+			{ID: 18, Minute: 15, DisplayMinute: "15'", Kind: api.EventKindCard, Team: match.HomeTeam, Player: stringPtr("Rice"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 19, Minute: 23, DisplayMinute: "23'", Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Saka"), Assist: stringPtr("Odegaard"), Timestamp: time.Now()},
+			{ID: 20, Minute: 34, DisplayMinute: "34'", Kind: api.EventKindSubstitution, Team: match.AwayTeam, PlayerIn: &api.EventPlayer{Name: "Gakpo"}, PlayerOut: &api.EventPlayer{Name: "Díaz"}, Timestamp: time.Now()},
+			{ID: 21, Minute: 45, DisplayMinute: "45+1'", Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Nunez"), Timestamp: time.Now()},
+			{ID: 22, Minute: 56, DisplayMinute: "56'", Kind: api.EventKindCard, Team: match.AwayTeam, Player: stringPtr("Van Dijk"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 23, Minute: 67, DisplayMinute: "67'", Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Martinelli"), Timestamp: time.Now()},
+			{ID: 24, Minute: 78, DisplayMinute: "78'", Kind: api.EventKindSubstitution, Team: match.HomeTeam, PlayerIn: &api.EventPlayer{Name: "Trossard"}, PlayerOut: &api.EventPlayer{Name: "Martinelli"}, Timestamp: time.Now()},
+			{ID: 25, Minute: 85, DisplayMinute: "85'", Kind: api.EventKindCard, Team: match.HomeTeam, Player: stringPtr("Gabriel"), Card: api.CardRed, Timestamp: time.Now()},
+			{ID: 26, Minute: 90, DisplayMinute: "90+3'", Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Diaz"), Assist: stringPtr("Salah"), Timestamp: time.Now()},
 		}
 
 	case 2005: // Barcelona 4-1 Sevilla (FT) - La Liga
 		events = []api.MatchEvent{
-			{ID: 27, Minute: 12, DisplayMinute: "12'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Timestamp: time.Now()},
-			{ID: 28, Minute: 23, DisplayMinute: "23'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Yamal"), Assist: stringPtr("Pedri"), Timestamp: time.Now()},
-			{ID: 29, Minute: 34, DisplayMinute: "34'", Type: "card", Team: match.AwayTeam, Player: stringPtr("Gudelj"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 30, Minute: 45, DisplayMinute: "45+2'", Type: "goal", Team: match.AwayTeam, Player: stringPtr("Lukebakio"), Timestamp: time.Now()},
-			{ID: 31, Minute: 56, DisplayMinute: "56'", Type: "substitution", Team: match.HomeTeam, Player: stringPtr("Ferran Torres"), EventType: stringPtr("sub_in"), Timestamp: time.Now()},
-			{ID: 32, Minute: 67, DisplayMinute: "67'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Raphinha"), Timestamp: time.Now()},
-			{ID: 33, Minute: 78, DisplayMinute: "78'", Type: "card", Team: match.HomeTeam, Player: stringPtr("Araujo"), EventType: stringPtr("yellow"), Timestamp: time.Now()},
-			{ID: 34, Minute: 90, DisplayMinute: "90+1'", Type: "goal", Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Assist: stringPtr("Yamal"), Timestamp: time.Now()},
+			{ID: 27, Minute: 12, DisplayMinute: "12'", Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Timestamp: time.Now()},
+			{ID: 28, Minute: 23, DisplayMinute: "23'", Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Yamal"), Assist: stringPtr("Pedri"), Timestamp: time.Now()},
+			{ID: 29, Minute: 34, DisplayMinute: "34'", Kind: api.EventKindCard, Team: match.AwayTeam, Player: stringPtr("Gudelj"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 30, Minute: 45, DisplayMinute: "45+2'", Kind: api.EventKindGoal, Team: match.AwayTeam, Player: stringPtr("Lukebakio"), Timestamp: time.Now()},
+			{ID: 31, Minute: 56, DisplayMinute: "56'", Kind: api.EventKindSubstitution, Team: match.HomeTeam, PlayerIn: &api.EventPlayer{Name: "Ferran Torres"}, PlayerOut: &api.EventPlayer{Name: "Raphinha"}, Timestamp: time.Now()},
+			{ID: 32, Minute: 67, DisplayMinute: "67'", Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Raphinha"), Timestamp: time.Now()},
+			{ID: 33, Minute: 78, DisplayMinute: "78'", Kind: api.EventKindCard, Team: match.HomeTeam, Player: stringPtr("Araujo"), Card: api.CardYellow, Timestamp: time.Now()},
+			{ID: 34, Minute: 90, DisplayMinute: "90+1'", Kind: api.EventKindGoal, Team: match.HomeTeam, Player: stringPtr("Lewandowski"), Assist: stringPtr("Yamal"), Timestamp: time.Now()},
 		}
 	}
 
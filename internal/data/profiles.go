@@ -0,0 +1,223 @@
+package data
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/0xjuanma/golazo/internal/theme"
+)
+
+// DefaultProfile is the profile stored in settings.yaml, used unless another is chosen.
+const DefaultProfile = "default"
+
+// profilesDirName is the config subdirectory holding named profiles, one <name>.yaml each.
+const profilesDirName = "profiles"
+
+// profileNamePattern restricts profile names to safe file names.
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// FavoriteTeam is a team followed in a profile.
+type FavoriteTeam struct {
+	ID   int    `yaml:"id"`
+	Name string `yaml:"name"`
+}
+
+// profileState holds the active profile and a cache of its favourite teams and
+// notification rules, which are looked up on every render and every notification.
+var profileState struct {
+	mu        sync.RWMutex
+	name      string
+	favorites map[int]bool        // nil until loaded
+	teams     []FavoriteTeam      // Favourite teams in the order they were added
+	rules     *NotificationConfig // nil if the profile has no rules of its own
+}
+
+// ActiveProfile returns the name of the profile in use.
+func ActiveProfile() string {
+	profileState.mu.RLock()
+	defer profileState.mu.RUnlock()
+	if profileState.name == "" {
+		return DefaultProfile
+	}
+	return profileState.name
+}
+
+// SetActiveProfile switches to the named profile. A profile that doesn't exist yet
+// starts with empty settings and is created on the first save.
+func SetActiveProfile(name string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 32 lowercase letters, digits, '-' or '_'", name)
+	}
+
+	profileState.mu.Lock()
+	defer profileState.mu.Unlock()
+	profileState.name = name
+	profileState.favorites = nil
+	profileState.teams = nil
+	profileState.rules = nil
+	return nil
+}
+
+// profilePath returns the settings file of a profile.
+func profilePath(name string) (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if name == DefaultProfile {
+		return filepath.Join(dir, settingsFileName), nil
+	}
+
+	return filepath.Join(dir, profilesDirName, name+".yaml"), nil
+}
+
+// Profiles returns the existing profiles, the default one first and the others
+// sorted by name. The active profile is included even if it hasn't been saved yet.
+func Profiles() ([]string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{ActiveProfile(): true}
+	files, err := filepath.Glob(filepath.Join(dir, profilesDirName, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".yaml")
+		if profileNamePattern.MatchString(name) {
+			names[name] = true
+		}
+	}
+	delete(names, DefaultProfile)
+
+	profiles := make([]string, 0, len(names))
+	for name := range names {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	return append([]string{DefaultProfile}, profiles...), nil
+}
+
+// cacheProfile remembers the favourite teams and notification rules of the active profile.
+func cacheProfile(settings *Settings) {
+	favorites := make(map[int]bool, len(settings.FavoriteTeams))
+	for _, team := range settings.FavoriteTeams {
+		favorites[team.ID] = true
+	}
+	var rules *NotificationConfig
+	if settings.Notifications != nil {
+		copied := *settings.Notifications
+		rules = &copied
+	}
+
+	profileState.mu.Lock()
+	defer profileState.mu.Unlock()
+	profileState.favorites = favorites
+	profileState.teams = slices.Clone(settings.FavoriteTeams)
+	profileState.rules = rules
+}
+
+// loadProfile loads the active profile into the cache unless it is already there.
+func loadProfile() {
+	profileState.mu.RLock()
+	loaded := profileState.favorites != nil
+	profileState.mu.RUnlock()
+
+	if !loaded {
+		if _, err := LoadSettings(); err != nil {
+			cacheProfile(&Settings{}) // Don't retry on every render
+		}
+	}
+}
+
+// IsFavoriteTeam reports whether a team is a favourite in the active profile.
+func IsFavoriteTeam(teamID int) bool {
+	loadProfile()
+
+	profileState.mu.RLock()
+	defer profileState.mu.RUnlock()
+	return profileState.favorites[teamID]
+}
+
+// FavoriteTeams returns the favourite teams of the active profile, in the order they were added.
+func FavoriteTeams() []FavoriteTeam {
+	loadProfile()
+
+	profileState.mu.RLock()
+	defer profileState.mu.RUnlock()
+	return slices.Clone(profileState.teams)
+}
+
+// ToggleFavoriteTeam adds a team to the active profile's favourites, or removes it.
+// Returns whether the team is now a favourite.
+func ToggleFavoriteTeam(teamID int, name string) (bool, error) {
+	settings, err := LoadSettings()
+	if err != nil {
+		return false, err
+	}
+
+	favorite := true
+	teams := settings.FavoriteTeams[:0]
+	for _, team := range settings.FavoriteTeams {
+		if team.ID == teamID {
+			favorite = false
+			continue
+		}
+		teams = append(teams, team)
+	}
+	if favorite {
+		teams = append(teams, FavoriteTeam{ID: teamID, Name: name})
+	}
+	settings.FavoriteTeams = teams
+
+	return favorite, SaveSettings(settings)
+}
+
+// NotificationRules returns the notification settings in effect: the active
+// profile's own rules if it has any, otherwise those of config.yaml.
+// The profile is read once and cached until it is switched or saved.
+func NotificationRules() NotificationConfig {
+	loadProfile()
+
+	profileState.mu.RLock()
+	defer profileState.mu.RUnlock()
+	if profileState.rules != nil {
+		return *profileState.rules
+	}
+	return CurrentConfig().Notifications
+}
+
+// themeOverridden is set when the theme was given with --theme or GOLAZO_THEME.
+var themeOverridden bool
+
+// OverrideTheme makes the theme of the current config win over NO_COLOR and profiles.
+// Call at startup when the theme was given on the command line or in the environment.
+func OverrideTheme() {
+	themeOverridden = true
+}
+
+// ThemeName returns the theme in effect: an overridden theme, then mono when
+// NO_COLOR is set, then the active profile's theme, then that of config.yaml.
+func ThemeName() string {
+	configured := CurrentConfig().Theme
+	if themeOverridden {
+		return configured
+	}
+	if theme.NoColorRequested() {
+		return theme.Mono
+	}
+	if settings, err := LoadSettings(); err == nil && settings.Theme != "" {
+		if _, err := theme.Get(settings.Theme); err == nil {
+			return settings.Theme
+		}
+	}
+	return configured
+}
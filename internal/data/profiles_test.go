@@ -0,0 +1,54 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNotificationRules(t *testing.T) {
+	useTempDirs(t)
+	configured := CurrentConfig().Notifications
+	rules := NotificationConfig{Enabled: true, Goals: true}
+
+	if err := SetActiveProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	if got := NotificationRules(); got != configured {
+		t.Errorf("NotificationRules() = %+v; want config.yaml's %+v for a new profile", got, configured)
+	}
+	dir, _ := ConfigDir()
+	if _, err := os.Stat(filepath.Join(dir, profilesDirName)); !os.IsNotExist(err) {
+		t.Errorf("profiles directory created before the first save: %v", err)
+	}
+
+	if err := SaveSettings(&Settings{Notifications: &rules}); err != nil {
+		t.Fatalf("SaveSettings() error = %v", err)
+	}
+	if got := NotificationRules(); got != rules {
+		t.Errorf("NotificationRules() = %+v; want the saved %+v", got, rules)
+	}
+
+	// The rules are read once per profile, not on every notification
+	path, _ := SettingsPath()
+	if err := settingsSchema.Save(path, &Settings{Version: settingsSchema.Version, Notifications: &NotificationConfig{}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := NotificationRules(); got != rules {
+		t.Errorf("NotificationRules() = %+v; want the cached %+v", got, rules)
+	}
+
+	// Switching profile reads the new one
+	if err := SetActiveProfile(DefaultProfile); err != nil {
+		t.Fatal(err)
+	}
+	if got := NotificationRules(); got != configured {
+		t.Errorf("NotificationRules() = %+v; want config.yaml's %+v for the default profile", got, configured)
+	}
+	if err := SetActiveProfile("work"); err != nil {
+		t.Fatal(err)
+	}
+	if got := NotificationRules(); got.Enabled {
+		t.Errorf("NotificationRules() = %+v; want the rules on disk after switching back", got)
+	}
+}
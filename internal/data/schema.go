@@ -0,0 +1,268 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileFormat is the encoding of a persisted file.
+type FileFormat int
+
+const (
+	FormatJSON FileFormat = iota
+	FormatYAML
+)
+
+// Migration upgrades a generically decoded file by one schema version.
+// Objects are map[string]any, arrays []any and JSON numbers json.Number.
+type Migration func(doc any) (any, error)
+
+// FileSchema describes the versioned on-disk format of a persisted file.
+// The version is stored in the top-level "version" key; files without one are version 0.
+type FileSchema struct {
+	Name       string // Used in messages, e.g. "settings"
+	Format     FileFormat
+	Version    int         // Current version, written by this golazo
+	Migrations []Migration // Migrations[i] upgrades version i to i+1
+	Strict     bool        // Report unknown fields instead of ignoring them
+	// KeepFile upgrades the file in memory only, for hand-edited files
+	// whose comments and layout would be lost by rewriting them.
+	KeepFile bool
+}
+
+// CorruptFileError reports a persisted file that can't be read: invalid syntax,
+// a version newer than this golazo supports or a failed migration.
+// The file itself is left untouched.
+type CorruptFileError struct {
+	Name string
+	Path string
+	Err  error
+}
+
+func (e *CorruptFileError) Error() string {
+	return fmt.Sprintf("invalid %s file %s: %v", e.Name, e.Path, e.Err)
+}
+
+func (e *CorruptFileError) Unwrap() error {
+	return e.Err
+}
+
+// Load reads the file at path into out, upgrading older versions first.
+// Returns an error wrapping os.ErrNotExist if the file doesn't exist and a
+// *CorruptFileError if it can't be read. Upgraded files are backed up to
+// <path>.v<version>.bak and rewritten in the current version, unless KeepFile is set.
+func (s FileSchema) Load(path string, out any) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	corrupt := func(err error) error {
+		return &CorruptFileError{Name: s.Name, Path: path, Err: err}
+	}
+
+	doc, err := s.decodeGeneric(raw)
+	if err != nil {
+		return corrupt(err)
+	}
+
+	version, err := docVersion(doc)
+	if err != nil {
+		return corrupt(err)
+	}
+	if version > s.Version {
+		return corrupt(fmt.Errorf("written by a newer golazo (version %d, this golazo supports up to %d)", version, s.Version))
+	}
+
+	if version < s.Version {
+		for v := version; v < s.Version; v++ {
+			if v >= len(s.Migrations) || s.Migrations[v] == nil {
+				return corrupt(fmt.Errorf("no upgrade from version %d", v))
+			}
+			if doc, err = s.Migrations[v](doc); err != nil {
+				return corrupt(fmt.Errorf("upgrade from version %d: %w", v, err))
+			}
+		}
+
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return corrupt(fmt.Errorf("upgrade to version %d did not produce an object", s.Version))
+		}
+		obj["version"] = s.Version
+
+		upgraded, err := s.encode(obj)
+		if err != nil {
+			return corrupt(err)
+		}
+		if !s.KeepFile {
+			if err := s.replace(path, raw, version, upgraded); err != nil {
+				return err
+			}
+		}
+		raw = upgraded
+	}
+
+	if err := s.decode(raw, out); err != nil {
+		return corrupt(err)
+	}
+	return nil
+}
+
+// Save writes v to path. v must carry the current version in its Version field.
+// The file is written to a temporary file first, so an interrupted save never
+// leaves a truncated file behind.
+func (s FileSchema) Save(path string, v any) error {
+	raw, err := s.encode(v)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", s.Name, err)
+	}
+	return writeFileAtomic(path, raw)
+}
+
+// Recover moves a corrupt file aside to <path>.corrupt so it can start over empty,
+// and records it for RecoveredFiles. Used for caches, which can be rebuilt.
+func (s FileSchema) Recover(err error) {
+	var corrupt *CorruptFileError
+	if !errors.As(err, &corrupt) {
+		return
+	}
+	backup := corrupt.Path + ".corrupt"
+	if os.Rename(corrupt.Path, backup) != nil {
+		return
+	}
+
+	recoveredMu.Lock()
+	defer recoveredMu.Unlock()
+	recovered = append(recovered, fmt.Sprintf("%v (moved to %s)", err, backup))
+}
+
+var (
+	recoveredMu sync.Mutex
+	recovered   []string
+)
+
+// RecoveredFiles describes the corrupt cache files moved aside during this run.
+func RecoveredFiles() []string {
+	recoveredMu.Lock()
+	defer recoveredMu.Unlock()
+	return append([]string(nil), recovered...)
+}
+
+// replace backs up the original file and writes its upgraded contents.
+func (s FileSchema) replace(path string, original []byte, version int, upgraded []byte) error {
+	backup := fmt.Sprintf("%s.v%d.bak", path, version)
+	if err := os.WriteFile(backup, original, 0644); err != nil {
+		return fmt.Errorf("back up %s file: %w", s.Name, err)
+	}
+	if err := writeFileAtomic(path, upgraded); err != nil {
+		return fmt.Errorf("upgrade %s file: %w", s.Name, err)
+	}
+	return nil
+}
+
+// decodeGeneric decodes raw without a target type, so older versions can be read.
+// An empty YAML file is an empty object.
+func (s FileSchema) decodeGeneric(raw []byte) (any, error) {
+	var doc any
+	if s.Format == FormatYAML {
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, err
+		}
+		if doc == nil {
+			doc = map[string]any{}
+		}
+		return doc, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber() // Keep large IDs exact
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// decode decodes raw into out, rejecting unknown fields for strict schemas.
+func (s FileSchema) decode(raw []byte, out any) error {
+	if s.Format == FormatYAML {
+		decoder := yaml.NewDecoder(bytes.NewReader(raw))
+		decoder.KnownFields(s.Strict)
+		if err := decoder.Decode(out); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	if s.Strict {
+		decoder.DisallowUnknownFields()
+	}
+	return decoder.Decode(out)
+}
+
+// encode encodes v in the schema's format.
+func (s FileSchema) encode(v any) ([]byte, error) {
+	if s.Format == FormatYAML {
+		return yaml.Marshal(v)
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
+
+// docVersion returns the "version" key of a decoded file, 0 if it has none.
+func docVersion(doc any) (int, error) {
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return 0, nil // Only unversioned files are top-level arrays
+	}
+
+	switch v := obj["version"].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return v, nil
+	case json.Number:
+		if n, err := strconv.Atoi(v.String()); err == nil {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid version %v", obj["version"])
+}
+
+// writeFileAtomic writes raw to a temporary file next to path and renames it into place.
+func writeFileAtomic(path string, raw []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// unversioned is the migration for files written before versioning was added,
+// whose fields are unchanged: Load adds the version key.
+func unversioned(doc any) (any, error) {
+	if _, ok := doc.(map[string]any); !ok {
+		return nil, fmt.Errorf("expected an object")
+	}
+	return doc, nil
+}
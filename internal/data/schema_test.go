@@ -0,0 +1,131 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testDoc is version 2 of a test file; version 1 named its field "old".
+type testDoc struct {
+	Version int    `json:"version"`
+	New     string `json:"new"`
+}
+
+func testSchema(keepFile bool) FileSchema {
+	return FileSchema{
+		Name:    "test",
+		Format:  FormatJSON,
+		Version: 2,
+		Migrations: []Migration{
+			unversioned,
+			func(doc any) (any, error) {
+				obj := doc.(map[string]any)
+				old, ok := obj["old"].(string)
+				if !ok {
+					return nil, fmt.Errorf("old is not a string")
+				}
+				delete(obj, "old")
+				obj["new"] = old
+				return obj, nil
+			},
+		},
+		Strict:   true,
+		KeepFile: keepFile,
+	}
+}
+
+func TestFileSchemaLoad(t *testing.T) {
+	tests := []struct {
+		content     string // Empty for no file
+		keepFile    bool
+		want        string // Decoded "new" field
+		wantCorrupt bool
+		wantMissing bool
+		wantBackup  string // Backup file suffix, empty for none
+		wantRewrite bool   // The file is rewritten in the current version
+		desc        string
+	}{
+		{`{"version": 2, "new": "a"}`, false, "a", false, false, "", false, "current version"},
+		{`{"old": "a"}`, false, "a", false, false, ".v0.bak", true, "unversioned file upgraded"},
+		{`{"version": 1, "old": "a"}`, false, "a", false, false, ".v1.bak", true, "older version upgraded"},
+		{`{"version": 1, "old": "a"}`, true, "a", false, false, "", false, "upgraded in memory only"},
+		{``, false, "", false, true, "", false, "missing file"},
+		{`{"version": 3, "new": "a"}`, false, "", true, false, "", false, "newer version"},
+		{`{"version": "two"}`, false, "", true, false, "", false, "invalid version"},
+		{`{"version": 2, "new": `, false, "", true, false, "", false, "invalid syntax"},
+		{`{"version": 1, "old": 7}`, false, "", true, false, "", false, "failed migration"},
+		{`{"version": 2, "new": "a", "extra": true}`, false, "", true, false, "", false, "unknown field"},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "test.json")
+		if tt.content != "" {
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		var doc testDoc
+		err := testSchema(tt.keepFile).Load(path, &doc)
+
+		var corrupt *CorruptFileError
+		if got := errors.As(err, &corrupt); got != tt.wantCorrupt {
+			t.Errorf("Load() error = %v; want corrupt %v - %s", err, tt.wantCorrupt, tt.desc)
+		}
+		if got := errors.Is(err, os.ErrNotExist); got != tt.wantMissing {
+			t.Errorf("Load() error = %v; want missing %v - %s", err, tt.wantMissing, tt.desc)
+		}
+		if err == nil && (doc.New != tt.want || doc.Version != 2) {
+			t.Errorf("Load() = %+v; want version 2 and %q - %s", doc, tt.want, tt.desc)
+		}
+
+		backups, _ := filepath.Glob(path + ".v*.bak")
+		switch {
+		case tt.wantBackup == "" && len(backups) > 0:
+			t.Errorf("backups = %v; want none - %s", backups, tt.desc)
+		case tt.wantBackup != "":
+			backup, err := os.ReadFile(path + tt.wantBackup)
+			if err != nil || string(backup) != tt.content {
+				t.Errorf("backup %s = %q, %v; want the original file - %s", tt.wantBackup, backup, err, tt.desc)
+			}
+		}
+
+		if tt.content == "" {
+			continue
+		}
+		raw, _ := os.ReadFile(path)
+		rewritten := string(raw) != tt.content
+		if rewritten != tt.wantRewrite {
+			t.Errorf("file rewritten = %v; want %v - %s", rewritten, tt.wantRewrite, tt.desc)
+		}
+		if rewritten && !strings.Contains(string(raw), `"version": 2`) {
+			t.Errorf("rewritten file = %s; want version 2 - %s", raw, tt.desc)
+		}
+	}
+}
+
+func TestFileSchemaRecover(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.json")
+	if err := os.WriteFile(path, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	schema := testSchema(false)
+	var doc testDoc
+	err := schema.Load(path, &doc)
+	schema.Recover(err)
+
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("corrupt file still in place: %v", err)
+	}
+	if raw, err := os.ReadFile(path + ".corrupt"); err != nil || string(raw) != "not json" {
+		t.Errorf("moved file = %q, %v; want the corrupt file", raw, err)
+	}
+	if files := RecoveredFiles(); len(files) == 0 || !strings.Contains(files[len(files)-1], path+".corrupt") {
+		t.Errorf("RecoveredFiles() = %v; want the moved file", files)
+	}
+}
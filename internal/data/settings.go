@@ -1,10 +1,12 @@
 package data
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
-
-	"gopkg.in/yaml.v3"
+	"strconv"
+	"strings"
 )
 
 const settingsFileName = "settings.yaml"
@@ -23,8 +25,8 @@ const (
 	RegionGlobal  = "Global"
 )
 
-// AllSupportedLeagues contains all leagues that Golazo supports organized by region.
-// This is the source of truth for available leagues.
+// AllSupportedLeagues contains the built-in leagues organized by region.
+// These are always available; Leagues merges them with the cached provider catalogue.
 var AllSupportedLeagues = map[string][]LeagueInfo{
 	RegionEurope: {
 		// Top 5 European Leagues
@@ -134,45 +136,56 @@ var AllSupportedLeagues = map[string][]LeagueInfo{
 	},
 }
 
-// Settings represents user preferences stored in settings.yaml.
+// settingsSchema is the versioned format of settings.yaml.
+var settingsSchema = FileSchema{
+	Name:       "settings",
+	Format:     FormatYAML,
+	Version:    1,
+	Migrations: []Migration{unversioned},
+}
+
+// Settings represents the user preferences of a profile, stored in settings.yaml
+// for the default profile and profiles/<name>.yaml for named ones.
 type Settings struct {
+	Version int `yaml:"version"`
+
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
 	SelectedLeagues []int `yaml:"selected_leagues"`
+
+	// FavoriteTeams are marked with a star wherever their matches are listed.
+	FavoriteTeams []FavoriteTeam `yaml:"favorite_teams,omitempty"`
+
+	// Notifications overrides the notifications section of config.yaml for this profile.
+	Notifications *NotificationConfig `yaml:"notifications,omitempty"`
+
+	// Theme overrides the theme of config.yaml for this profile.
+	Theme string `yaml:"theme,omitempty"`
 }
 
-// SettingsPath returns the path to the settings file.
+// SettingsPath returns the path to the settings file of the active profile.
 func SettingsPath() (string, error) {
-	dir, err := ConfigDir()
-	if err != nil {
-		return "", err
-	}
-	return filepath.Join(dir, settingsFileName), nil
+	return profilePath(ActiveProfile())
 }
 
-// LoadSettings reads settings from the settings.yaml file.
+// LoadSettings reads settings from the settings.yaml file, upgrading older versions.
 // Returns default settings (empty selection = all leagues) if file doesn't exist.
+// A corrupt file is reported as a *CorruptFileError and must not be saved over.
 func LoadSettings() (*Settings, error) {
 	path, err := SettingsPath()
 	if err != nil {
 		return &Settings{}, err
 	}
 
-	data, err := os.ReadFile(path)
-	if err != nil {
-		if os.IsNotExist(err) {
-			// No settings file - return empty settings (will use all leagues)
-			return &Settings{}, nil
-		}
-		return &Settings{}, err
-	}
-
 	var settings Settings
-	if err := yaml.Unmarshal(data, &settings); err != nil {
-		// Invalid YAML - return empty settings
-		return &Settings{}, nil
+	if err := settingsSchema.Load(path, &settings); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return &Settings{}, err
+		}
+		// No settings file - empty settings (will use default leagues)
 	}
 
+	cacheProfile(&settings)
 	return &settings, nil
 }
 
@@ -183,12 +196,17 @@ func SaveSettings(settings *Settings) error {
 		return err
 	}
 
-	data, err := yaml.Marshal(settings)
-	if err != nil {
-		return err
+	// Named profiles live in a subdirectory, created on their first save
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create profiles directory: %w", err)
 	}
 
-	return os.WriteFile(path, data, 0644)
+	settings.Version = settingsSchema.Version
+	if err := settingsSchema.Save(path, settings); err != nil {
+		return err
+	}
+	cacheProfile(settings)
+	return nil
 }
 
 // DefaultLeagueIDs contains the default leagues used when no selection is made.
@@ -242,7 +260,44 @@ func GetAllRegions() []string {
 	return []string{RegionEurope, RegionAmerica, RegionGlobal}
 }
 
-// GetLeaguesForRegion returns all leagues for a specific region.
+// GetLeaguesForRegion returns all known leagues for a specific region,
+// including competitions from the cached provider catalogue.
 func GetLeaguesForRegion(region string) []LeagueInfo {
-	return AllSupportedLeagues[region]
+	return Leagues()[region]
+}
+
+// FindLeague resolves a known league from a FotMob ID or a name, case-insensitively.
+// Exact name matches win over whole-word matches, which win over partial ones:
+// "serie a" matches "Serie A" rather than "Serie A Femminile", and "champions"
+// matches "UEFA Champions League" rather than "EFL Championship".
+func FindLeague(query string) (LeagueInfo, bool) {
+	query = strings.TrimSpace(query)
+	id, err := strconv.Atoi(query)
+	lower := strings.ToLower(query)
+
+	all := Leagues()
+	var words, partial []LeagueInfo
+	for _, region := range GetAllRegions() {
+		for _, league := range all[region] {
+			name := strings.ToLower(league.Name)
+			switch {
+			case err == nil && league.ID == id:
+				return league, true
+			case name == lower:
+				return league, true
+			case strings.Contains(" "+name+" ", " "+lower+" "):
+				words = append(words, league)
+			case strings.Contains(name, lower):
+				partial = append(partial, league)
+			}
+		}
+	}
+
+	if err != nil && len(words) > 0 {
+		return words[0], true
+	}
+	if err != nil && len(partial) > 0 {
+		return partial[0], true
+	}
+	return LeagueInfo{}, false
 }
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/0xjuanma/golazo/internal/theme"
+	"gopkg.in/yaml.v3"
+)
+
+// ImportMode is how imported settings are combined with a profile's own.
+type ImportMode int
+
+const (
+	// ImportMerge adds the imported leagues and favourite teams to the profile's,
+	// and takes the imported notification rules and theme if there are any.
+	ImportMerge ImportMode = iota
+	// ImportReplace replaces the profile's settings with the imported ones.
+	ImportReplace
+)
+
+// ImportResult summarises an import.
+type ImportResult struct {
+	AddedLeagues int    // Leagues newly selected
+	AddedTeams   int    // Favourite teams newly added
+	Unknown      []int  // League IDs missing from the league catalogue
+	UnknownTheme string // Imported theme that doesn't exist here, left out
+}
+
+// ReadSettingsFile reads settings exported from any profile. Older versions are
+// upgraded in memory only: the file itself is never modified.
+func ReadSettingsFile(path string) (*Settings, error) {
+	schema := settingsSchema
+	schema.KeepFile = true
+	schema.Strict = true // Shared files are hand-edited, report typos
+
+	var settings Settings
+	if err := schema.Load(path, &settings); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("read settings: %w", err)
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// ExportSettings returns settings in settings.yaml format for sharing, with the
+// name of every league and favourite team as a comment next to its ID.
+func ExportSettings(settings *Settings) ([]byte, error) {
+	exported := *settings
+	exported.Version = settingsSchema.Version
+
+	var doc yaml.Node
+	if err := doc.Encode(&exported); err != nil {
+		return nil, err
+	}
+
+	names := make(map[int]string)
+	for _, leagues := range Leagues() {
+		for _, league := range leagues {
+			names[league.ID] = fmt.Sprintf("%s (%s)", league.Name, league.Country)
+		}
+	}
+
+	// Mapping nodes alternate keys and values
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value != "selected_leagues" {
+			continue
+		}
+		for _, item := range doc.Content[i+1].Content {
+			var id int
+			if item.Decode(&id) == nil && names[id] != "" {
+				item.LineComment = names[id]
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportSettings combines imported settings with the active profile's and saves them.
+// League IDs missing from the league catalogue are reported, and only imported
+// when keepUnknown is set. An unknown theme is reported and never imported.
+func ImportSettings(imported *Settings, mode ImportMode, keepUnknown bool) (ImportResult, error) {
+	var result ImportResult
+
+	current, err := LoadSettings()
+	if err != nil && mode == ImportMerge {
+		return result, err
+	}
+	if mode == ImportReplace {
+		current = &Settings{Notifications: imported.Notifications}
+	} else if imported.Notifications != nil {
+		current.Notifications = imported.Notifications
+	}
+
+	if imported.Theme != "" {
+		if _, err := theme.Get(imported.Theme); err != nil {
+			result.UnknownTheme = imported.Theme
+		} else {
+			current.Theme = imported.Theme
+		}
+	}
+
+	known := make(map[int]bool)
+	for _, leagues := range Leagues() {
+		for _, league := range leagues {
+			known[league.ID] = true
+		}
+	}
+
+	for _, id := range imported.SelectedLeagues {
+		if !known[id] {
+			result.Unknown = append(result.Unknown, id)
+			if !keepUnknown {
+				continue
+			}
+		}
+		if !slices.Contains(current.SelectedLeagues, id) {
+			current.SelectedLeagues = append(current.SelectedLeagues, id)
+			result.AddedLeagues++
+		}
+	}
+
+	for _, team := range imported.FavoriteTeams {
+		if !slices.ContainsFunc(current.FavoriteTeams, func(t FavoriteTeam) bool { return t.ID == team.ID }) {
+			current.FavoriteTeams = append(current.FavoriteTeams, team)
+			result.AddedTeams++
+		}
+	}
+
+	return result, SaveSettings(current)
+}
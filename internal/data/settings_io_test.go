@@ -0,0 +1,112 @@
+package data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestImportSettings(t *testing.T) {
+	const (
+		premierLeague = 47
+		laLiga        = 87
+		unknownLeague = 999999999
+	)
+	arsenal := FavoriteTeam{ID: 9825, Name: "Arsenal"}
+	barcelona := FavoriteTeam{ID: 8634, Name: "Barcelona"}
+	rules := &NotificationConfig{Enabled: true, Goals: true}
+
+	tests := []struct {
+		imported     Settings
+		mode         ImportMode
+		keepUnknown  bool
+		wantLeagues  []int
+		wantTeams    []FavoriteTeam
+		wantRules    *NotificationConfig
+		wantTheme    string
+		wantAdded    int
+		wantUnknown  []int
+		unknownTheme string
+		desc         string
+	}{
+		{
+			imported:    Settings{SelectedLeagues: []int{premierLeague, laLiga, unknownLeague}, FavoriteTeams: []FavoriteTeam{arsenal, barcelona}},
+			mode:        ImportMerge,
+			wantLeagues: []int{premierLeague, laLiga},
+			wantTeams:   []FavoriteTeam{arsenal, barcelona},
+			wantTheme:   "light",
+			wantAdded:   1,
+			wantUnknown: []int{unknownLeague},
+			desc:        "merge skips unknown leagues and duplicates",
+		},
+		{
+			imported:    Settings{SelectedLeagues: []int{unknownLeague}},
+			mode:        ImportMerge,
+			keepUnknown: true,
+			wantLeagues: []int{premierLeague, unknownLeague},
+			wantTeams:   []FavoriteTeam{arsenal},
+			wantTheme:   "light",
+			wantAdded:   1,
+			wantUnknown: []int{unknownLeague},
+			desc:        "merge keeps unknown leagues when asked",
+		},
+		{
+			imported:    Settings{Notifications: rules, Theme: "mono"},
+			mode:        ImportMerge,
+			wantLeagues: []int{premierLeague},
+			wantTeams:   []FavoriteTeam{arsenal},
+			wantRules:   rules,
+			wantTheme:   "mono",
+			desc:        "merge takes notification rules and theme",
+		},
+		{
+			imported:     Settings{Theme: "sepia"},
+			mode:         ImportMerge,
+			wantLeagues:  []int{premierLeague},
+			wantTeams:    []FavoriteTeam{arsenal},
+			wantTheme:    "light",
+			unknownTheme: "sepia",
+			desc:         "merge skips an unknown theme",
+		},
+		{
+			imported:    Settings{SelectedLeagues: []int{laLiga}, FavoriteTeams: []FavoriteTeam{barcelona}, Notifications: rules},
+			mode:        ImportReplace,
+			wantLeagues: []int{laLiga},
+			wantTeams:   []FavoriteTeam{barcelona},
+			wantRules:   rules,
+			wantAdded:   1,
+			desc:        "replace drops the profile's settings",
+		},
+	}
+
+	for _, tt := range tests {
+		useTempDirs(t)
+		if err := SaveSettings(&Settings{SelectedLeagues: []int{premierLeague}, FavoriteTeams: []FavoriteTeam{arsenal}, Theme: "light"}); err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := ImportSettings(&tt.imported, tt.mode, tt.keepUnknown)
+		if err != nil {
+			t.Fatalf("ImportSettings() error = %v - %s", err, tt.desc)
+		}
+		got, err := LoadSettings()
+		if err != nil {
+			t.Fatalf("LoadSettings() error = %v - %s", err, tt.desc)
+		}
+
+		if !slices.Equal(got.SelectedLeagues, tt.wantLeagues) {
+			t.Errorf("leagues = %v; want %v - %s", got.SelectedLeagues, tt.wantLeagues, tt.desc)
+		}
+		if !slices.Equal(got.FavoriteTeams, tt.wantTeams) {
+			t.Errorf("teams = %v; want %v - %s", got.FavoriteTeams, tt.wantTeams, tt.desc)
+		}
+		if (got.Notifications == nil) != (tt.wantRules == nil) || (got.Notifications != nil && *got.Notifications != *tt.wantRules) {
+			t.Errorf("notifications = %v; want %v - %s", got.Notifications, tt.wantRules, tt.desc)
+		}
+		if got.Theme != tt.wantTheme {
+			t.Errorf("theme = %q; want %q - %s", got.Theme, tt.wantTheme, tt.desc)
+		}
+		if result.AddedLeagues != tt.wantAdded || !slices.Equal(result.Unknown, tt.wantUnknown) || result.UnknownTheme != tt.unknownTheme {
+			t.Errorf("result = %+v; want %d added, unknown %v and theme %q - %s", result, tt.wantAdded, tt.wantUnknown, tt.unknownTheme, tt.desc)
+		}
+	}
+}
@@ -2,7 +2,7 @@
 package data
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -83,90 +83,148 @@ type LiveUpdate struct {
 	Time    time.Time
 }
 
+// liveUpdatesSchema is the versioned format of a match's stored live updates.
+// Version 0 was a bare array of updates; version 1 wraps it in an object.
+var liveUpdatesSchema = FileSchema{
+	Name:    "live updates",
+	Format:  FormatJSON,
+	Version: 1,
+	Migrations: []Migration{
+		func(doc any) (any, error) {
+			updates, ok := doc.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of updates")
+			}
+			return map[string]any{"updates": updates}, nil
+		},
+	},
+}
+
+// liveUpdatesFile is the on-disk format of a match's live updates.
+type liveUpdatesFile struct {
+	Version int          `json:"version"`
+	Updates []LiveUpdate `json:"updates"`
+}
+
+// liveUpdatesPath returns the path to the stored live updates of a match.
+func liveUpdatesPath(matchID int) (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, fmt.Sprintf("updates_%d.json", matchID)), nil
+}
+
 // SaveLiveUpdate appends a live update to the storage.
 func SaveLiveUpdate(matchID int, update string) error {
-	dir, err := ConfigDir()
+	updatesFile, err := liveUpdatesPath(matchID)
 	if err != nil {
 		return err
 	}
 
-	updatesFile := filepath.Join(dir, fmt.Sprintf("updates_%d.json", matchID))
-
-	var updates []LiveUpdate
-	if data, err := os.ReadFile(updatesFile); err == nil {
-		// Best effort to load existing updates; if unmarshal fails, start with empty slice
-		if err := json.Unmarshal(data, &updates); err != nil {
-			// Invalid JSON in file - start fresh with empty slice
-			updates = []LiveUpdate{}
-		}
+	var file liveUpdatesFile
+	if err := liveUpdatesSchema.Load(updatesFile, &file); err != nil {
+		// Start fresh if the file doesn't exist or is corrupt (moved aside)
+		liveUpdatesSchema.Recover(err)
+		file = liveUpdatesFile{}
 	}
 
-	updates = append(updates, LiveUpdate{
+	file.Version = liveUpdatesSchema.Version
+	file.Updates = append(file.Updates, LiveUpdate{
 		MatchID: matchID,
 		Update:  update,
 		Time:    time.Now(),
 	})
 
-	data, err := json.Marshal(updates)
-	if err != nil {
-		return fmt.Errorf("marshal updates: %w", err)
-	}
-
-	return os.WriteFile(updatesFile, data, 0644)
+	return liveUpdatesSchema.Save(updatesFile, file)
 }
 
 // LiveUpdates retrieves live updates for a match.
 func LiveUpdates(matchID int) ([]string, error) {
-	dir, err := ConfigDir()
+	updatesFile, err := liveUpdatesPath(matchID)
 	if err != nil {
 		return nil, err
 	}
 
-	updatesFile := filepath.Join(dir, fmt.Sprintf("updates_%d.json", matchID))
-	data, err := os.ReadFile(updatesFile)
-	if err != nil {
-		return []string{}, nil // Return empty if file doesn't exist
-	}
-
-	var updates []LiveUpdate
-	if err := json.Unmarshal(data, &updates); err != nil {
-		return nil, fmt.Errorf("unmarshal updates: %w", err)
+	var file liveUpdatesFile
+	if err := liveUpdatesSchema.Load(updatesFile, &file); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil // Return empty if file doesn't exist
+		}
+		return nil, err
 	}
 
-	result := make([]string, 0, len(updates))
-	for _, update := range updates {
+	result := make([]string, 0, len(file.Updates))
+	for _, update := range file.Updates {
 		result = append(result, update.Update)
 	}
 
 	return result, nil
 }
 
-// LoadLatestVersion reads the latest known version from storage.
-// Returns empty string if file doesn't exist or can't be read.
-func LoadLatestVersion() (string, error) {
+// latestVersionSchema is the versioned format of the latest known release.
+// Version 0 held the bare version tag, which reads as a YAML string; version 1
+// is a YAML object, so the file keeps its name.
+var latestVersionSchema = FileSchema{
+	Name:    "latest version",
+	Format:  FormatYAML,
+	Version: 1,
+	Migrations: []Migration{
+		func(doc any) (any, error) {
+			switch tag := doc.(type) {
+			case string:
+				return map[string]any{"latest": strings.TrimSpace(tag)}, nil
+			case map[string]any:
+				return tag, nil // Empty file
+			}
+			return nil, fmt.Errorf("expected a version tag")
+		},
+	},
+}
+
+// latestVersionFile is the on-disk format of the latest known release.
+type latestVersionFile struct {
+	Version int    `yaml:"version"`
+	Latest  string `yaml:"latest"`
+}
+
+// latestVersionPath returns the path to the latest known release.
+func latestVersionPath() (string, error) {
 	dir, err := ConfigDir()
 	if err != nil {
 		return "", err
 	}
+	return filepath.Join(dir, "latest_version.txt"), nil
+}
 
-	versionFile := filepath.Join(dir, "latest_version.txt")
-	data, err := os.ReadFile(versionFile)
+// LoadLatestVersion reads the latest known version from storage.
+// Returns empty string if file doesn't exist or can't be read; a corrupt file is moved aside.
+func LoadLatestVersion() (string, error) {
+	versionFile, err := latestVersionPath()
 	if err != nil {
-		return "", nil // Return empty if file doesn't exist
+		return "", err
 	}
 
-	return strings.TrimSpace(string(data)), nil
+	var file latestVersionFile
+	if err := latestVersionSchema.Load(versionFile, &file); err != nil {
+		latestVersionSchema.Recover(err)
+		return "", nil
+	}
+
+	return file.Latest, nil
 }
 
 // SaveLatestVersion saves the latest version to storage.
 func SaveLatestVersion(version string) error {
-	dir, err := ConfigDir()
+	versionFile, err := latestVersionPath()
 	if err != nil {
 		return err
 	}
 
-	versionFile := filepath.Join(dir, "latest_version.txt")
-	return os.WriteFile(versionFile, []byte(strings.TrimSpace(version)), 0644)
+	return latestVersionSchema.Save(versionFile, latestVersionFile{
+		Version: latestVersionSchema.Version,
+		Latest:  strings.TrimSpace(version),
+	})
 }
 
 // CheckLatestVersion fetches the latest version from GitHub releases.
@@ -218,12 +276,11 @@ func CheckLatestVersion() (string, error) {
 // ShouldCheckVersion returns true if we should check for a new version.
 // Checks if the latest_version.txt file is older than 24 hours.
 func ShouldCheckVersion() bool {
-	dir, err := ConfigDir()
+	versionFile, err := latestVersionPath()
 	if err != nil {
 		return false
 	}
 
-	versionFile := filepath.Join(dir, "latest_version.txt")
 	info, err := os.Stat(versionFile)
 	if err != nil {
 		return true // File doesn't exist, should check
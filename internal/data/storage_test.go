@@ -0,0 +1,124 @@
+package data
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// useTempDirs points the config and cache directories at a temporary directory.
+func useTempDirs(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("XDG_CACHE_HOME", dir)
+	reset := func() {
+		catalogState.loaded = nil
+		profileState.name, profileState.favorites, profileState.teams, profileState.rules = "", nil, nil, nil
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestLiveUpdates(t *testing.T) {
+	tests := []struct {
+		content     string // Stored before saving "new", empty for no file
+		want        []string
+		wantCorrupt bool // The stored file is moved aside
+		desc        string
+	}{
+		{``, []string{"new"}, false, "no file"},
+		{`[{"MatchID": 1, "Update": "old"}]`, []string{"old", "new"}, false, "unversioned file upgraded"},
+		{`{"version": 1, "updates": [{"MatchID": 1, "Update": "old"}]}`, []string{"old", "new"}, false, "current version"},
+		{`[{"MatchID": 1`, []string{"new"}, true, "corrupt file"},
+	}
+
+	for _, tt := range tests {
+		useTempDirs(t)
+		path, err := liveUpdatesPath(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.content != "" {
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := SaveLiveUpdate(1, "new"); err != nil {
+			t.Errorf("SaveLiveUpdate() error = %v - %s", err, tt.desc)
+		}
+		got, err := LiveUpdates(1)
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("LiveUpdates() = %v, %v; want %v - %s", got, err, tt.want, tt.desc)
+		}
+		if _, err := os.Stat(path + ".corrupt"); (err == nil) != tt.wantCorrupt {
+			t.Errorf("corrupt file moved aside = %v; want %v - %s", err == nil, tt.wantCorrupt, tt.desc)
+		}
+	}
+}
+
+func TestLiveUpdatesCorrupt(t *testing.T) {
+	useTempDirs(t)
+	path, err := liveUpdatesPath(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var corrupt *CorruptFileError
+	if _, err := LiveUpdates(1); !errors.As(err, &corrupt) {
+		t.Errorf("LiveUpdates() error = %v; want a corrupt file error", err)
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	tests := []struct {
+		content     string // Empty for no file
+		want        string
+		wantCorrupt bool // The file is moved aside
+		desc        string
+	}{
+		{``, "", false, "no file"},
+		{"v1.2.3", "v1.2.3", false, "unversioned tag upgraded"},
+		{"version: 1\nlatest: v1.2.3\n", "v1.2.3", false, "current version"},
+		{"version: 2\nlatest: v1.2.3\n", "", true, "newer version"},
+		{"[v1.2.3", "", true, "invalid syntax"},
+	}
+
+	for _, tt := range tests {
+		useTempDirs(t)
+		path, err := latestVersionPath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.content != "" {
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if got, err := LoadLatestVersion(); err != nil || got != tt.want {
+			t.Errorf("LoadLatestVersion() = %q, %v; want %q - %s", got, err, tt.want, tt.desc)
+		}
+		if _, err := os.Stat(path + ".corrupt"); (err == nil) != tt.wantCorrupt {
+			t.Errorf("corrupt file moved aside = %v; want %v - %s", err == nil, tt.wantCorrupt, tt.desc)
+		}
+	}
+
+	useTempDirs(t)
+	if err := SaveLatestVersion(" v2.0.0\n"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := LoadLatestVersion(); err != nil || got != "v2.0.0" {
+		t.Errorf("LoadLatestVersion() after save = %q, %v; want v2.0.0", got, err)
+	}
+	path, _ := latestVersionPath()
+	if backups, _ := filepath.Glob(path + ".v*.bak"); len(backups) > 0 {
+		t.Errorf("backups = %v; want none after saving the current version", backups)
+	}
+}
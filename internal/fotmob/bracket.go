@@ -0,0 +1,181 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// fotmobPlayoff represents the knockout section of the FotMob leagues endpoint
+type fotmobPlayoff struct {
+	Playoff *struct {
+		Rounds []struct {
+			Stage    string          `json:"stage"` // e.g., "1/8", "1/4", "1/2", "final"
+			Matchups []fotmobMatchup `json:"matchups"`
+		} `json:"rounds"`
+	} `json:"playoff"`
+}
+
+// fotmobMatchup represents a knockout tie between two teams
+type fotmobMatchup struct {
+	DrawOrder         int         `json:"drawOrder"`
+	HomeTeamID        json.Number `json:"homeTeamId"`
+	HomeTeam          string      `json:"homeTeam"`
+	HomeTeamShortName string      `json:"homeTeamShortName"`
+	AwayTeamID        json.Number `json:"awayTeamId"`
+	AwayTeam          string      `json:"awayTeam"`
+	AwayTeamShortName string      `json:"awayTeamShortName"`
+	Winner            json.Number `json:"winner,omitempty"` // Winning team ID once decided
+	AggregatedResult  *struct {
+		HomeScore int `json:"homeScore"`
+		AwayScore int `json:"awayScore"`
+	} `json:"aggregatedResult,omitempty"`
+	Matches []struct {
+		MatchID json.Number       `json:"matchId"`
+		Home    fotmobFixtureTeam `json:"home"`
+		Away    fotmobFixtureTeam `json:"away"`
+		Status  struct {
+			status
+			ScoreStr  string `json:"scoreStr,omitempty"`
+			Penalties []int  `json:"penalties,omitempty"` // [home, away] shootout score of this leg
+		} `json:"status"`
+	} `json:"matches"`
+}
+
+// knockoutStageNames maps FotMob stage codes to round names.
+var knockoutStageNames = map[string]string{
+	"1/64":  "Round of 128",
+	"1/32":  "Round of 64",
+	"1/16":  "Round of 32",
+	"1/8":   "Round of 16",
+	"1/4":   "Quarter-finals",
+	"1/2":   "Semi-finals",
+	"final": "Final",
+}
+
+// toAPITie converts a matchup to api.KnockoutTie.
+// The aggregate is summed from finished legs when FotMob does not provide it.
+func (m fotmobMatchup) toAPITie() api.KnockoutTie {
+	tie := api.KnockoutTie{
+		HomeTeam: api.Team{ID: parseInt(m.HomeTeamID.String()), Name: m.HomeTeam, ShortName: m.HomeTeamShortName},
+		AwayTeam: api.Team{ID: parseInt(m.AwayTeamID.String()), Name: m.AwayTeam, ShortName: m.AwayTeamShortName},
+		WinnerID: parseInt(m.Winner.String()),
+	}
+
+	var homeAgg, awayAgg int
+	played := false
+	for _, leg := range m.Matches {
+		f := fotmobTeamFixture{ID: leg.MatchID, Home: leg.Home, Away: leg.Away, Status: leg.Status.status}
+		match := f.toAPIMatch()
+		if match.HomeScore == nil && leg.Status.ScoreStr != "" {
+			var home, away int
+			if n, _ := fmt.Sscanf(leg.Status.ScoreStr, "%d - %d", &home, &away); n == 2 {
+				match.HomeScore, match.AwayScore = &home, &away
+			}
+		}
+		tie.Legs = append(tie.Legs, match)
+
+		if match.HomeScore != nil && match.AwayScore != nil {
+			played = true
+			// Legs alternate venues, so orient the score to the tie's home team
+			if match.HomeTeam.ID == tie.AwayTeam.ID {
+				homeAgg += *match.AwayScore
+				awayAgg += *match.HomeScore
+			} else {
+				homeAgg += *match.HomeScore
+				awayAgg += *match.AwayScore
+			}
+		}
+
+		if len(leg.Status.Penalties) == 2 {
+			home, away := leg.Status.Penalties[0], leg.Status.Penalties[1]
+			if match.HomeTeam.ID == tie.AwayTeam.ID {
+				home, away = away, home
+			}
+			tie.HomePenalties, tie.AwayPenalties = &home, &away
+		}
+	}
+
+	if m.AggregatedResult != nil {
+		homeAgg, awayAgg = m.AggregatedResult.HomeScore, m.AggregatedResult.AwayScore
+		played = true
+	}
+	if played {
+		tie.HomeAggregate, tie.AwayAggregate = &homeAgg, &awayAgg
+	}
+
+	// Decide the winner from the aggregate when FotMob has not set it
+	if tie.WinnerID == 0 && played && len(tie.Legs) > 0 && tie.Legs[len(tie.Legs)-1].Status == api.MatchStatusFinished {
+		switch {
+		case tie.HomePenalties != nil && *tie.HomePenalties != *tie.AwayPenalties:
+			tie.WinnerID = tie.HomeTeam.ID
+			if *tie.AwayPenalties > *tie.HomePenalties {
+				tie.WinnerID = tie.AwayTeam.ID
+			}
+		case homeAgg > awayAgg:
+			tie.WinnerID = tie.HomeTeam.ID
+		case awayAgg > homeAgg:
+			tie.WinnerID = tie.AwayTeam.ID
+		}
+	}
+	return tie
+}
+
+// toAPIBracket converts the playoff data to api.KnockoutBracket.
+// Rounds not drawn yet are left out. Returns nil if the competition has no
+// knockout rounds with ties.
+func (p fotmobPlayoff) toAPIBracket() *api.KnockoutBracket {
+	if p.Playoff == nil || len(p.Playoff.Rounds) == 0 {
+		return nil
+	}
+
+	bracket := &api.KnockoutBracket{}
+	for _, r := range p.Playoff.Rounds {
+		// Rounds are listed before their draw, with no matchups yet
+		if len(r.Matchups) == 0 {
+			continue
+		}
+
+		name, ok := knockoutStageNames[strings.ToLower(r.Stage)]
+		if !ok {
+			name = r.Stage
+		}
+
+		matchups := append([]fotmobMatchup(nil), r.Matchups...)
+		sort.SliceStable(matchups, func(i, j int) bool {
+			return matchups[i].DrawOrder < matchups[j].DrawOrder
+		})
+
+		round := api.KnockoutRound{Name: name}
+		for _, m := range matchups {
+			round.Ties = append(round.Ties, m.toAPITie())
+		}
+		bracket.Rounds = append(bracket.Rounds, round)
+	}
+	if len(bracket.Rounds) == 0 {
+		return nil
+	}
+	return bracket
+}
+
+// KnockoutBracket retrieves the knockout rounds of a cup competition.
+// Uses league name to detect parent leagues, as for LeagueTable.
+func (c *Client) KnockoutBracket(ctx context.Context, leagueID int, leagueName string) (*api.KnockoutBracket, error) {
+	effectiveID := getParentLeagueID(leagueName, leagueID)
+
+	var response fotmobPlayoff
+	reqURL := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, effectiveID)
+	if err := c.fetchJSON(ctx, reqURL, fmt.Sprintf("league %d bracket", effectiveID), &response); err != nil {
+		return nil, err
+	}
+
+	bracket := response.toAPIBracket()
+	if bracket == nil {
+		return nil, fmt.Errorf("no knockout rounds available for league %d", effectiveID)
+	}
+	return bracket, nil
+}
@@ -0,0 +1,49 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestToAPIBracket(t *testing.T) {
+	tie := `{"homeTeamId":1,"homeTeam":"A","awayTeamId":2,"awayTeam":"B"}`
+
+	tests := []struct {
+		raw        string
+		wantRounds []string // Nil when no bracket is expected
+		desc       string
+	}{
+		{`{}`, nil, "no playoff"},
+		{`{"playoff":{"rounds":[]}}`, nil, "no rounds"},
+		{`{"playoff":{"rounds":[{"stage":"1/8","matchups":[]},{"stage":"1/4"}]}}`, nil, "no round drawn"},
+		{`{"playoff":{"rounds":[{"stage":"1/8","matchups":[` + tie + `,` + tie + `]},{"stage":"1/4","matchups":[` + tie + `]}]}}`, []string{"Round of 16", "Quarter-finals"}, "all rounds drawn"},
+		{`{"playoff":{"rounds":[{"stage":"1/4","matchups":[` + tie + `]},{"stage":"1/2","matchups":[]},{"stage":"final"}]}}`, []string{"Quarter-finals"}, "later rounds not drawn"},
+	}
+
+	for _, tt := range tests {
+		var p fotmobPlayoff
+		if err := json.Unmarshal([]byte(tt.raw), &p); err != nil {
+			t.Fatalf("json.Unmarshal() error = %v - %s", err, tt.desc)
+		}
+
+		bracket := p.toAPIBracket()
+		if bracket == nil {
+			if tt.wantRounds != nil {
+				t.Errorf("toAPIBracket() = nil; want rounds %v - %s", tt.wantRounds, tt.desc)
+			}
+			continue
+		}
+
+		var got []string
+		for _, round := range bracket.Rounds {
+			got = append(got, round.Name)
+			if len(round.Ties) == 0 {
+				t.Errorf("toAPIBracket() round %q has no ties - %s", round.Name, tt.desc)
+			}
+		}
+		if !slices.Equal(got, tt.wantRounds) {
+			t.Errorf("toAPIBracket() rounds = %v; want %v - %s", got, tt.wantRounds, tt.desc)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
 // CacheConfig holds configuration for API response caching.
@@ -16,14 +17,16 @@ type CacheConfig struct {
 	MaxDetailsCache int           // Maximum number of match details to cache
 }
 
-// DefaultCacheConfig returns sensible defaults for caching.
+// DefaultCacheConfig returns the caching settings, with TTLs from the configuration
+// (cache.* in config.yaml, see data.DefaultConfig for defaults).
 func DefaultCacheConfig() CacheConfig {
+	ttls := data.CurrentConfig().Cache
 	return CacheConfig{
-		MatchesTTL:      15 * time.Minute, // Matches list cache (stats view uses client-side filtering)
-		MatchDetailsTTL: 5 * time.Minute,  // Details for live matches need fresher data
-		LiveMatchesTTL:  2 * time.Minute,  // Live matches list cache (quick nav doesn't re-fetch)
-		MaxMatchesCache: 10,               // Cache up to 10 date queries
-		MaxDetailsCache: 100,              // Cache up to 100 match details
+		MatchesTTL:      ttls.MatchesTTL,
+		MatchDetailsTTL: ttls.MatchDetailsTTL,
+		LiveMatchesTTL:  ttls.LiveMatchesTTL,
+		MaxMatchesCache: 10,  // Cache up to 10 date queries
+		MaxDetailsCache: 100, // Cache up to 100 match details
 	}
 }
 
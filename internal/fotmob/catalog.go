@@ -0,0 +1,45 @@
+package fotmob
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// fotmobLeagueGroup represents a country (or the international section) and its competitions
+type fotmobLeagueGroup struct {
+	Name    string `json:"name"` // Country name, e.g., "England", or "International"
+	Leagues []struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"leagues"`
+}
+
+// fotmobAllLeagues represents the response from the FotMob allLeagues endpoint
+type fotmobAllLeagues struct {
+	International []fotmobLeagueGroup `json:"international"`
+	Countries     []fotmobLeagueGroup `json:"countries"`
+}
+
+// LeagueCatalog retrieves every competition FotMob covers, with its country.
+// Used to let users follow leagues beyond the built-in list.
+func (c *Client) LeagueCatalog(ctx context.Context) ([]data.LeagueInfo, error) {
+	var response fotmobAllLeagues
+	reqURL := fmt.Sprintf("%s/allLeagues", c.baseURL)
+	if err := c.fetchJSON(ctx, reqURL, "league catalog", &response); err != nil {
+		return nil, err
+	}
+
+	var leagues []data.LeagueInfo
+	for _, group := range append(response.International, response.Countries...) {
+		for _, l := range group.Leagues {
+			leagues = append(leagues, data.LeagueInfo{ID: l.ID, Name: l.Name, Country: group.Name})
+		}
+	}
+
+	if len(leagues) == 0 {
+		return nil, fmt.Errorf("no leagues in catalog")
+	}
+	return leagues, nil
+}
@@ -459,7 +459,7 @@ func getParentLeagueID(leagueName string, leagueID int) int {
 // LeagueTable retrieves the league table/standings for a specific league.
 // Handles both regular league tables and knockout competition tables (e.g., Champions League).
 // Uses league name to detect parent leagues for knockout competitions.
-func (c *Client) LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]api.LeagueTableEntry, error) {
+func (c *Client) LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]api.LeagueTableGroup, error) {
 	// First, determine the effective league ID (may be parent for knockout competitions)
 	effectiveID := getParentLeagueID(leagueName, leagueID)
 
@@ -467,8 +467,8 @@ func (c *Client) LeagueTable(ctx context.Context, leagueID int, leagueName strin
 	return c.fetchLeagueTable(ctx, effectiveID)
 }
 
-// fetchLeagueTable fetches the league table for a specific league ID.
-func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableEntry, error) {
+// fetchLeagueTable fetches all standings tables for a specific league ID.
+func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.LeagueTableGroup, error) {
 	// Apply rate limiting
 	c.rateLimiter.Wait()
 
@@ -491,22 +491,15 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 		return nil, fmt.Errorf("unexpected status code %d for league %d table", resp.StatusCode, leagueID)
 	}
 
-	// FotMob returns table at either:
-	// - Regular leagues: table[0].data.table.all[]
-	// - Knockout competitions (e.g., Champions League): table[0].data.tables[0].table.all[]
+	// FotMob returns tables at either:
+	// - Regular leagues: table[].data.table
+	// - Competitions with groups (e.g., Champions League, Libertadores): table[].data.tables[].table
 	var response struct {
 		Table []struct {
 			Data struct {
-				// Regular league table
-				Table struct {
-					All []fotmobTableRow `json:"all"`
-				} `json:"table"`
-				// Knockout competition tables (e.g., Champions League)
-				Tables []struct {
-					Table struct {
-						All []fotmobTableRow `json:"all"`
-					} `json:"table"`
-				} `json:"tables"`
+				fotmobTable
+				// Group tables
+				Tables []fotmobTable `json:"tables"`
 			} `json:"data"`
 		} `json:"table"`
 	}
@@ -515,27 +508,51 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 		return nil, fmt.Errorf("decode league table response for league %d: %w", leagueID, err)
 	}
 
-	// Extract table rows - try regular format first, then knockout format
-	var tableData []fotmobTableRow
-	if len(response.Table) > 0 {
-		data := response.Table[0].Data
-		// Try regular league format first
-		if len(data.Table.All) > 0 {
-			tableData = data.Table.All
-		} else if len(data.Tables) > 0 && len(data.Tables[0].Table.All) > 0 {
-			// Fall back to knockout competition format
-			tableData = data.Tables[0].Table.All
+	var groups []api.LeagueTableGroup
+	for _, t := range response.Table {
+		if len(t.Data.Table.All) > 0 {
+			groups = append(groups, t.Data.toAPITableGroup())
+		}
+		for _, group := range t.Data.Tables {
+			if len(group.Table.All) > 0 {
+				groups = append(groups, group.toAPITableGroup())
+			}
 		}
 	}
 
-	if len(tableData) == 0 {
+	if len(groups) == 0 {
 		return nil, fmt.Errorf("no table data available for league %d", leagueID)
 	}
 
-	entries := make([]api.LeagueTableEntry, 0, len(tableData))
-	for _, row := range tableData {
-		entries = append(entries, row.toAPITableEntry())
+	return groups, nil
+}
+
+// fetchJSON performs a rate-limited GET request and decodes the JSON response into out.
+// what describes the resource in error messages (e.g., "player 123").
+func (c *Client) fetchJSON(ctx context.Context, url, what string, out any) error {
+	// Apply rate limiting
+	c.rateLimiter.Wait()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("create request for %s: %w", what, err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch %s: %w", what, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, what)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s response: %w", what, err)
 	}
 
-	return entries, nil
+	return nil
 }
@@ -2,8 +2,6 @@
 package fotmob
 
 import (
-	"encoding/json"
-	"os"
 	"path/filepath"
 	"sync"
 	"time"
@@ -18,6 +16,14 @@ const (
 	EmptyCacheExpiry = 7 * 24 * time.Hour
 )
 
+// emptyCacheSchema is the versioned format of the empty results cache.
+// The file has carried version 1 from the start, so there are no migrations yet.
+var emptyCacheSchema = data.FileSchema{
+	Name:    "empty results cache",
+	Format:  data.FormatJSON,
+	Version: 1,
+}
+
 // EmptyResultsCache stores date+league combinations that returned 0 matches.
 // This avoids unnecessary API calls for leagues with no matches on specific dates.
 type EmptyResultsCache struct {
@@ -49,16 +55,17 @@ func NewEmptyResultsCache() (*EmptyResultsCache, error) {
 	cache := &EmptyResultsCache{
 		filePath: filepath.Join(configDir, EmptyCacheFileName),
 		data: EmptyCacheData{
-			Version:      1,
+			Version:      emptyCacheSchema.Version,
 			EmptyResults: make(map[string]EmptyCacheEntry),
 		},
 	}
 
 	// Load existing cache file if it exists
 	if err := cache.load(); err != nil {
-		// If file doesn't exist or is corrupted, start fresh
+		// If file doesn't exist or is corrupted, start fresh (corrupt files are moved aside)
+		emptyCacheSchema.Recover(err)
 		cache.data = EmptyCacheData{
-			Version:      1,
+			Version:      emptyCacheSchema.Version,
 			EmptyResults: make(map[string]EmptyCacheEntry),
 		}
 	}
@@ -104,22 +111,18 @@ func (c *EmptyResultsCache) Save() error {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	data, err := json.MarshalIndent(c.data, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile(c.filePath, data, 0644)
+	return emptyCacheSchema.Save(c.filePath, c.data)
 }
 
 // load reads the cache from disk.
 func (c *EmptyResultsCache) load() error {
-	data, err := os.ReadFile(c.filePath)
-	if err != nil {
+	if err := emptyCacheSchema.Load(c.filePath, &c.data); err != nil {
 		return err
 	}
-
-	return json.Unmarshal(data, &c.data)
+	if c.data.EmptyResults == nil {
+		c.data.EmptyResults = make(map[string]EmptyCacheEntry)
+	}
+	return nil
 }
 
 // cleanExpired removes expired entries from the cache.
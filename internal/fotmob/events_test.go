@@ -0,0 +1,162 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// parseDetails converts a FotMob match details payload, as returned by the API.
+func parseDetails(t *testing.T, payload string) *api.MatchDetails {
+	t.Helper()
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(payload), &m); err != nil {
+		t.Fatalf("unmarshal payload: %v", err)
+	}
+	return m.toAPIMatchDetails()
+}
+
+// eventPayload wraps FotMob match events in a match details payload between teams 10 and 20.
+func eventPayload(events string) string {
+	return `{
+		"general": {"matchId": "4506263", "homeTeam": {"id": 10, "name": "Arsenal"}, "awayTeam": {"id": 20, "name": "Chelsea"}},
+		"content": {"matchFacts": {"events": {"events": [` + events + `]}}}
+	}`
+}
+
+// eventFields summarises the parsed fields of an event for comparison.
+type eventFields struct {
+	Kind      api.EventKind
+	Team      int
+	Player    string // Name#ID
+	IsPenalty bool
+	IsOwnGoal bool
+	Card      api.CardColor
+	In, Out   string // Name#ID
+	Decision  string
+	Detail    string
+}
+
+func fieldsOf(e api.MatchEvent) eventFields {
+	player := func(name string, id int) string {
+		if name == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s#%d", name, id)
+	}
+	f := eventFields{Kind: e.Kind, Team: e.Team.ID, IsPenalty: e.IsPenalty, IsOwnGoal: e.IsOwnGoal, Card: e.Card, Detail: e.Detail}
+	if e.Player != nil {
+		f.Player = player(*e.Player, e.PlayerID)
+	}
+	if e.PlayerIn != nil {
+		f.In = player(e.PlayerIn.Name, e.PlayerIn.ID)
+	}
+	if e.PlayerOut != nil {
+		f.Out = player(e.PlayerOut.Name, e.PlayerOut.ID)
+	}
+	if e.Decision != nil {
+		f.Decision = *e.Decision
+	}
+	return f
+}
+
+func TestMatchDetailsEvents(t *testing.T) {
+	tests := []struct {
+		event string
+		want  eventFields
+		desc  string
+	}{
+		{
+			`{"type": "Goal", "time": 12, "isHome": true, "player": {"id": 961995, "name": "Bukayo Saka"}}`,
+			eventFields{Kind: api.EventKindGoal, Team: 10, Player: "Bukayo Saka#961995"},
+			"goal",
+		},
+		{
+			`{"type": "Goal", "time": 30, "isHome": false, "playerId": 737066, "fullName": "Cole Palmer", "isPenalty": true}`,
+			eventFields{Kind: api.EventKindGoal, Team: 20, Player: "Cole Palmer#737066", IsPenalty: true},
+			"penalty goal",
+		},
+		{
+			`{"type": "Goal", "time": 41, "isHome": true, "player": {"id": 1, "name": "Levi Colwill"}, "ownGoal": true}`,
+			eventFields{Kind: api.EventKindGoal, Team: 10, Player: "Levi Colwill#1", IsOwnGoal: true},
+			"own goal",
+		},
+		{
+			`{"type": "Goal", "time": 55, "isHome": true, "nameStr": "Kai Havertz", "VAR": {"decision": {"key": "goal_cancelled", "value": "Goal cancelled"}}}`,
+			eventFields{Kind: api.EventKindDisallowedGoal, Team: 10, Player: "Kai Havertz#0", Decision: "Goal cancelled"},
+			"goal cancelled by VAR",
+		},
+		{
+			`{"type": "VAR", "time": 56, "isHome": false, "VAR": {"decision": {"key": "penalty_not_awarded", "value": "Penalty not awarded"}}}`,
+			eventFields{Kind: api.EventKindVAR, Team: 20, Decision: "Penalty not awarded"},
+			"VAR review",
+		},
+		{
+			`{"type": "MissedPenalty", "time": 60, "isHome": false, "player": {"id": 2, "name": "Enzo Fernández"}}`,
+			eventFields{Kind: api.EventKindMissedPenalty, Team: 20, Player: "Enzo Fernández#2", IsPenalty: true},
+			"missed penalty",
+		},
+		{
+			`{"type": "Card", "time": 20, "isHome": true, "player": {"id": 3, "name": "Declan Rice"}, "card": "Yellow"}`,
+			eventFields{Kind: api.EventKindCard, Team: 10, Player: "Declan Rice#3", Card: api.CardYellow},
+			"yellow card",
+		},
+		{
+			`{"type": "Card", "time": 70, "isHome": true, "player": {"id": 3, "name": "Declan Rice"}, "card": "YellowRed"}`,
+			eventFields{Kind: api.EventKindCard, Team: 10, Player: "Declan Rice#3", Card: api.CardSecondYellow},
+			"second yellow",
+		},
+		{
+			`{"type": "Card", "time": 80, "isHome": false, "player": {"id": 4, "name": "Moisés Caicedo"}, "card": "Red"}`,
+			eventFields{Kind: api.EventKindCard, Team: 20, Player: "Moisés Caicedo#4", Card: api.CardRed},
+			"straight red",
+		},
+		{
+			`{"type": "Substitution", "time": 65, "isHome": true, "player": {"id": 5, "name": "Leandro Trossard"}, "swap": [{"name": "Leandro Trossard", "id": "5"}, {"name": "Gabriel Martinelli", "id": "6"}]}`,
+			eventFields{Kind: api.EventKindSubstitution, Team: 10, In: "Leandro Trossard#5", Out: "Gabriel Martinelli#6"},
+			"substitution in and out",
+		},
+		{
+			`{"type": "AddedTime", "time": 45, "isHome": false, "timeStr": "+4"}`,
+			eventFields{Kind: api.EventKindAddedTime, Team: 20, Detail: "+4"},
+			"added time",
+		},
+		{
+			`{"type": "Injury", "time": 33, "isHome": true}`,
+			eventFields{Kind: api.EventKindOther, Team: 10, Detail: "Injury"},
+			"unrecognised event",
+		},
+	}
+
+	for _, tt := range tests {
+		details := parseDetails(t, eventPayload(tt.event))
+		if len(details.Events) != 1 {
+			t.Errorf("toAPIMatchDetails() = %d events; want 1 - %s", len(details.Events), tt.desc)
+			continue
+		}
+		if got := fieldsOf(details.Events[0]); got != tt.want {
+			t.Errorf("toAPIMatchDetails() event = %+v; want %+v - %s", got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestMatchDetailsEventOrder(t *testing.T) {
+	details := parseDetails(t, eventPayload(`
+		{"type": "Goal", "time": 70, "eventId": 3, "isHome": true},
+		{"type": "Half", "time": 45, "homeScore": 1, "awayScore": 0},
+		{"type": "Goal", "time": 10, "eventId": 1, "isHome": true}`))
+
+	var ids []int
+	for _, e := range details.Events {
+		ids = append(ids, e.ID)
+	}
+	if !slices.Equal(ids, []int{1, 3}) {
+		t.Errorf("event IDs = %v; want [1 3], by minute and without the half-time marker", ids)
+	}
+	if details.HalfTimeScore == nil || *details.HalfTimeScore.Home != 1 || *details.HalfTimeScore.Away != 0 {
+		t.Errorf("HalfTimeScore = %+v; want 1-0", details.HalfTimeScore)
+	}
+}
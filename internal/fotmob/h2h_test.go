@@ -0,0 +1,91 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// h2hMeeting returns a FotMob head-to-head meeting; finished and cancelled are JSON booleans or "null".
+func h2hMeeting(utcTime string, homeID int, homeName string, awayID int, awayName, score, finished, cancelled string) string {
+	return fmt.Sprintf(`{
+		"time": {"utcTime": %q},
+		"league": {"id": 47, "name": "Premier League"},
+		"home": {"id": "%d", "name": %q},
+		"away": {"id": "%d", "name": %q},
+		"status": {"finished": %s, "cancelled": %s, "scoreStr": %q}
+	}`, utcTime, homeID, homeName, awayID, awayName, finished, cancelled, score)
+}
+
+func TestParseHeadToHead(t *testing.T) {
+	// The current match is Arsenal (10) v Chelsea (20)
+	homeWin := h2hMeeting("2024-04-23T19:00:00Z", 10, "Arsenal", 20, "Chelsea", "5 - 0", "true", "false")
+	awayWin := h2hMeeting("2023-10-21T16:30:00Z", 20, "Chelsea", 10, "Arsenal", "2 - 1", "true", "false")
+	draw := h2hMeeting("2025-03-16T13:30:00Z", 20, "Chelsea", 10, "Arsenal", "1 - 1", "true", "false")
+	upcoming := h2hMeeting("2026-11-08T17:30:00Z", 10, "Arsenal", 20, "Chelsea", "", "false", "false")
+	cancelled := h2hMeeting("2022-09-17T11:30:00Z", 10, "Arsenal", 20, "Chelsea", "", "null", "true")
+
+	tests := []struct {
+		h2h        string
+		wantNil    bool
+		wantCounts [3]int   // Home wins, draws, away wins
+		wantScores []string // Most recent first
+		desc       string
+	}{
+		{
+			h2h:        `{"summary": [15, 10, 12], "matches": [` + homeWin + `, ` + draw + `, ` + awayWin + `]}`,
+			wantCounts: [3]int{15, 10, 12},
+			wantScores: []string{"Chelsea 1-1 Arsenal", "Arsenal 5-0 Chelsea", "Chelsea 2-1 Arsenal"},
+			desc:       "summary from FotMob, meetings newest first",
+		},
+		{
+			h2h:        `{"matches": [` + homeWin + `, ` + awayWin + `, ` + draw + `]}`,
+			wantCounts: [3]int{1, 1, 1},
+			wantScores: []string{"Chelsea 1-1 Arsenal", "Arsenal 5-0 Chelsea", "Chelsea 2-1 Arsenal"},
+			desc:       "summary counted from the meetings, away venue swapped",
+		},
+		{
+			h2h:        `{"summary": [1, 0], "matches": [` + upcoming + `, ` + homeWin + `, ` + cancelled + `]}`,
+			wantCounts: [3]int{1, 0, 0},
+			wantScores: []string{"Arsenal 5-0 Chelsea"},
+			desc:       "upcoming and cancelled meetings skipped, incomplete summary ignored",
+		},
+		{
+			h2h:        `{"summary": [0, 0, 0], "matches": []}`,
+			wantCounts: [3]int{0, 0, 0},
+			desc:       "teams never met",
+		},
+		{h2h: `{"matches": []}`, wantNil: true, desc: "no meetings and no summary"},
+		{h2h: `null`, wantNil: true, desc: "null head-to-head"},
+		{h2h: `false`, wantNil: true, desc: "head-to-head unavailable"},
+		{h2h: ``, wantNil: true, desc: "head-to-head missing"},
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchDetails
+		m.General.HomeTeam.ID = 10
+		m.General.AwayTeam.ID = 20
+		m.Content.H2H = json.RawMessage(tt.h2h)
+
+		got := m.parseHeadToHead()
+		if (got == nil) != tt.wantNil {
+			t.Errorf("parseHeadToHead() = %+v; want nil %v - %s", got, tt.wantNil, tt.desc)
+			continue
+		}
+		if got == nil {
+			continue
+		}
+
+		if counts := [3]int{got.HomeWins, got.Draws, got.AwayWins}; counts != tt.wantCounts {
+			t.Errorf("parseHeadToHead() counts = %v; want %v - %s", counts, tt.wantCounts, tt.desc)
+		}
+		var scores []string
+		for _, match := range got.Matches {
+			scores = append(scores, fmt.Sprintf("%s %d-%d %s", match.HomeTeam.Name, *match.HomeScore, *match.AwayScore, match.AwayTeam.Name))
+		}
+		if !slices.Equal(scores, tt.wantScores) {
+			t.Errorf("parseHeadToHead() meetings = %q; want %q - %s", scores, tt.wantScores, tt.desc)
+		}
+	}
+}
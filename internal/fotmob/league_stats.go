@@ -0,0 +1,144 @@
+package fotmob
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// leagueStatsLimit is the number of leaders kept per stat.
+const leagueStatsLimit = 20
+
+// statsDataHost is FotMob's data host, which serves the full stat rankings.
+const statsDataHost = "data.fotmob.com"
+
+// FotMob stat names for the leaderboards shown by golazo
+const (
+	statGoals       = "goals"
+	statAssists     = "goal_assist"
+	statCleanSheets = "clean_sheet"
+)
+
+// fotmobLeagueStats represents the stats section of the FotMob leagues endpoint
+type fotmobLeagueStats struct {
+	Stats struct {
+		Players []struct {
+			Name        string `json:"name"`        // Stat name, e.g., "goals"
+			FetchAllURL string `json:"fetchAllUrl"` // Full ranking, served from FotMob's data host
+			TopThree    []struct {
+				ID       int     `json:"id"`
+				Name     string  `json:"name"`
+				TeamName string  `json:"teamName"`
+				Value    float64 `json:"value"`
+				Rank     int     `json:"rank"`
+			} `json:"topThree"`
+		} `json:"players"`
+	} `json:"stats"`
+}
+
+// fotmobStatList represents a full stat ranking from FotMob's data host
+type fotmobStatList struct {
+	TopLists []struct {
+		StatList []struct {
+			ParticipantName string  `json:"ParticipantName"`
+			ParticiantID    int     `json:"ParticiantId"` // Misspelled by FotMob
+			ParticipantID   int     `json:"ParticipantId"`
+			TeamName        string  `json:"TeamName"`
+			StatValue       float64 `json:"StatValue"`
+			Rank            int     `json:"Rank"`
+		} `json:"StatList"`
+	} `json:"TopLists"`
+}
+
+// toAPILeaders converts a full stat ranking to api.StatLeader entries.
+func (l fotmobStatList) toAPILeaders() []api.StatLeader {
+	if len(l.TopLists) == 0 {
+		return nil
+	}
+	var leaders []api.StatLeader
+	for _, s := range l.TopLists[0].StatList {
+		if len(leaders) == leagueStatsLimit {
+			break
+		}
+		id := s.ParticipantID
+		if id == 0 {
+			id = s.ParticiantID
+		}
+		leaders = append(leaders, api.StatLeader{
+			Rank:     s.Rank,
+			PlayerID: id,
+			Name:     s.ParticipantName,
+			Team:     s.TeamName,
+			Value:    int(math.Round(s.StatValue)),
+		})
+	}
+	return leaders
+}
+
+// isStatsDataURL reports whether a ranking URL from the payload points at FotMob's
+// data host over HTTPS, so that no other URL found in a response is followed.
+func isStatsDataURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "https" && u.Host == statsDataHost
+}
+
+// LeagueStats retrieves a league's top scorers, assist providers and clean sheet leaders.
+// Each ranking is fetched in full from FotMob's data host; if that fails, or the payload
+// points elsewhere, the top three from the league overview are used.
+// Uses league name to detect parent leagues, as for LeagueTable.
+func (c *Client) LeagueStats(ctx context.Context, leagueID int, leagueName string) (*api.LeagueStats, error) {
+	leagueID = getParentLeagueID(leagueName, leagueID)
+
+	var response fotmobLeagueStats
+	reqURL := fmt.Sprintf("%s/leagues?id=%d", c.baseURL, leagueID)
+	if err := c.fetchJSON(ctx, reqURL, fmt.Sprintf("league %d stats", leagueID), &response); err != nil {
+		return nil, err
+	}
+
+	if len(response.Stats.Players) == 0 {
+		return nil, fmt.Errorf("no stats available for league %d", leagueID)
+	}
+
+	stats := &api.LeagueStats{}
+	for _, stat := range response.Stats.Players {
+		var target *[]api.StatLeader
+		switch stat.Name {
+		case statGoals:
+			target = &stats.Scorers
+		case statAssists:
+			target = &stats.Assists
+		case statCleanSheets:
+			target = &stats.CleanSheets
+		default:
+			continue
+		}
+
+		if isStatsDataURL(stat.FetchAllURL) {
+			var list fotmobStatList
+			if err := c.fetchJSON(ctx, stat.FetchAllURL, fmt.Sprintf("league %d %s", leagueID, stat.Name), &list); err == nil {
+				*target = list.toAPILeaders()
+			}
+		}
+		if len(*target) > 0 {
+			continue
+		}
+
+		for _, p := range stat.TopThree {
+			*target = append(*target, api.StatLeader{
+				Rank:     p.Rank,
+				PlayerID: p.ID,
+				Name:     p.Name,
+				Team:     p.TeamName,
+				Value:    int(math.Round(p.Value)),
+			})
+		}
+	}
+
+	return stats, nil
+}
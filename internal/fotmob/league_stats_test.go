@@ -0,0 +1,27 @@
+package fotmob
+
+import "testing"
+
+func TestIsStatsDataURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+		desc string
+	}{
+		{"https://data.fotmob.com/stats/47/season/23685/goals.json", true, "data host"},
+		{"", false, "empty"},
+		{"http://data.fotmob.com/stats/47/season/23685/goals.json", false, "plain http"},
+		{"https://www.fotmob.com/api/leagues?id=47", false, "other FotMob host"},
+		{"https://data.fotmob.com.example.com/goals.json", false, "lookalike host"},
+		{"https://data.fotmob.com:8443/goals.json", false, "other port"},
+		{"https://user@evil.example/goals.json", false, "other host"},
+		{"/stats/47/goals.json", false, "relative"},
+		{"://data.fotmob.com", false, "unparsable"},
+	}
+
+	for _, tt := range tests {
+		if got := isStatsDataURL(tt.url); got != tt.want {
+			t.Errorf("isStatsDataURL(%q) = %v; want %v - %s", tt.url, got, tt.want, tt.desc)
+		}
+	}
+}
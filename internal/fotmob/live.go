@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -91,7 +90,7 @@ func LeagueIDAtIndex(index int) int {
 	return activeLeagues[index]
 }
 
-// LiveUpdateParser parses match events into live update strings.
+// LiveUpdateParser prepares match events for the live updates timeline.
 type LiveUpdateParser struct{}
 
 // NewLiveUpdateParser creates a new live update parser.
@@ -99,103 +98,24 @@ func NewLiveUpdateParser() *LiveUpdateParser {
 	return &LiveUpdateParser{}
 }
 
-// ParseEvents converts match events into human-readable update strings.
+// ParseEvents returns the events to show in the live updates timeline.
 // Events are sorted by minute in descending order (most recent first).
-func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent, homeTeam, awayTeam api.Team) []string {
-	// Sort events by minute descending (most recent first)
-	sorted := make([]api.MatchEvent, len(events))
-	copy(sorted, events)
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Minute > sorted[j].Minute
-	})
-
-	updates := make([]string, 0, len(sorted))
-	for _, event := range sorted {
-		update := p.formatEvent(event, homeTeam, awayTeam)
-		if update != "" {
-			updates = append(updates, update)
+// Added time events are skipped - not useful in the timeline.
+func (p *LiveUpdateParser) ParseEvents(events []api.MatchEvent) []api.MatchEvent {
+	updates := make([]api.MatchEvent, 0, len(events))
+	for _, event := range events {
+		if event.Kind == api.EventKindAddedTime {
+			continue
 		}
+		updates = append(updates, event)
 	}
 
-	return updates
-}
-
-// Event type prefixes for visual identification (used by UI for coloring)
-const (
-	EventPrefixGoal        = "●" // Solid circle - goals (red)
-	EventPrefixYellowCard  = "▪" // Square - yellow card (cyan)
-	EventPrefixRedCard     = "■" // Filled square - red card (red)
-	EventPrefixSubstitution = "↔" // Arrow - substitution (dim)
-	EventPrefixOther       = "·" // Small dot - other events (dim)
-)
-
-// formatEvent formats a single event into a readable string with symbol prefix and label.
-// Format: SYMBOL TIME' [LABEL] details [H] or [A]
-// Symbol prefixes are used by the UI to apply appropriate colors.
-// [H] or [A] suffix indicates home or away team for UI alignment.
-func (p *LiveUpdateParser) formatEvent(event api.MatchEvent, homeTeam, awayTeam api.Team) string {
-	// Determine if this is a home or away team event
-	isHome := event.Team.ID == homeTeam.ID
-	if event.Team.ID == 0 && event.Team.ShortName != "" {
-		// Fallback to short name matching if ID not set
-		isHome = event.Team.ShortName == homeTeam.ShortName
-	}
-	teamMarker := "[A]"
-	if isHome {
-		teamMarker = "[H]"
-	}
-
-	switch strings.ToLower(event.Type) {
-	case "goal":
-		player := "Unknown"
-		if event.Player != nil {
-			player = *event.Player
-		}
-		return fmt.Sprintf("%s %d' [GOAL] %s %s", EventPrefixGoal, event.Minute, player, teamMarker)
+	// Sort events by minute descending (most recent first)
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].Minute > updates[j].Minute
+	})
 
-	case "card":
-		player := "Unknown"
-		if event.Player != nil {
-			player = *event.Player
-		}
-		cardType := "yellow"
-		if event.EventType != nil {
-			cardType = strings.ToLower(*event.EventType)
-		}
-		prefix := EventPrefixYellowCard
-		if cardType == "red" || cardType == "redcard" || cardType == "secondyellow" {
-			prefix = EventPrefixRedCard
-		}
-		return fmt.Sprintf("%s %d' [CARD] %s %s", prefix, event.Minute, player, teamMarker)
-
-	case "substitution":
-		// Player = player going out, Assist = player coming in (repurposed)
-		playerOut := "Unknown"
-		playerIn := "Unknown"
-		if event.Player != nil && *event.Player != "" {
-			playerOut = *event.Player
-		}
-		if event.Assist != nil && *event.Assist != "" {
-			playerIn = *event.Assist
-		}
-		// Format: show both players - "OUT→ Player | IN← Player"
-		// Using special markers for UI to color-code: {OUT} and {IN}
-		return fmt.Sprintf("%s %d' [SUB] {OUT}%s {IN}%s %s", EventPrefixSubstitution, event.Minute, playerOut, playerIn, teamMarker)
-
-	case "addedtime":
-		// Skip added time events - not useful
-		return ""
-
-	default:
-		player := ""
-		if event.Player != nil {
-			player = *event.Player
-		}
-		if player != "" {
-			return fmt.Sprintf("%s %d' %s %s", EventPrefixOther, event.Minute, player, teamMarker)
-		}
-		return fmt.Sprintf("%s %d' %s %s", EventPrefixOther, event.Minute, event.Type, teamMarker)
-	}
+	return updates
 }
 
 // NewEvents compares two event lists and returns only new events.
@@ -0,0 +1,116 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestParseMomentum(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []api.MomentumPoint
+		desc string
+	}{
+		{``, nil, "no momentum"},
+		{`false`, nil, "unavailable"},
+		{`{"main": {"data": []}}`, nil, "empty series"},
+		{`{"main": {"data": "bad"}}`, nil, "malformed series"},
+		{`{"main": {"data": [{"minute": 1, "value": 12}, {"minute": 1.5, "value": -40.5}]}}`,
+			[]api.MomentumPoint{{Minute: 1, Value: 12}, {Minute: 1.5, Value: -40.5}}, "series"},
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchDetails
+		m.Content.Momentum = json.RawMessage(tt.raw)
+
+		if got := m.parseMomentum(); !slices.Equal(got, tt.want) {
+			t.Errorf("parseMomentum() = %v; want %v - %s", got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestParseShots(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []string // Player, side and outcome of each shot
+		desc string
+	}{
+		{``, nil, "no shot map"},
+		{`null`, nil, "unavailable"},
+		{`{"shots": 3}`, nil, "malformed shot map"},
+		{`{"shots": [
+			{"id": 2, "eventType": "Miss", "teamId": 20, "playerName": "Palmer", "min": 40},
+			{"id": 1, "eventType": "Goal", "teamId": 10, "playerName": "Saka", "min": 12},
+			{"id": 3, "eventType": "AttemptSaved", "teamId": 10, "playerName": "Rice", "min": 40, "minAdded": 2},
+			{"id": 4, "eventType": "AttemptSaved", "teamId": 20, "playerName": "Jackson", "min": 70, "isBlocked": true},
+			{"id": 5, "eventType": "Post", "teamId": 20, "playerName": "Madueke", "min": 80, "isOnTarget": true}
+		]}`, []string{"Saka home goal", "Palmer away off_target", "Rice home on_target", "Jackson away blocked", "Madueke away on_target"}, "outcomes in chronological order"},
+		{`{"shots": [
+			{"id": 1, "eventType": "Goal", "teamId": 10, "playerName": "Saka", "min": 12},
+			{"id": 2, "eventType": "Miss", "teamId": 20, "playerName": "Palmer", "min": "late"},
+			"not a shot"
+		]}`, []string{"Saka home goal"}, "malformed shots skipped"},
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchDetails
+		m.General.HomeTeam.ID = 10
+		m.Content.Shotmap = json.RawMessage(tt.raw)
+
+		var got []string
+		for _, shot := range m.parseShots() {
+			side := "away"
+			if shot.IsHome {
+				side = "home"
+			}
+			got = append(got, shot.Player+" "+side+" "+string(shot.Outcome))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("parseShots() = %q; want %q - %s", got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestParseExpectedGoals(t *testing.T) {
+	stat := func(home, away string) []api.MatchStatistic {
+		return []api.MatchStatistic{
+			{Key: "ball_possession", HomeValue: "55", AwayValue: "45"},
+			{Key: "expected_goals", HomeValue: home, AwayValue: away},
+		}
+	}
+	shots := []api.Shot{
+		{IsHome: true, XG: 0.5},
+		{IsHome: true, XG: 0.25},
+		{IsHome: false, XG: 0.125},
+		{IsHome: true, XG: 0.9, IsOwnGoal: true},
+	}
+
+	tests := []struct {
+		stats    []api.MatchStatistic
+		shots    []api.Shot
+		wantHome float64
+		wantAway float64
+		wantNil  bool
+		desc     string
+	}{
+		{stat("1.84", "0.62"), shots, 1.84, 0.62, false, "from the statistic"},
+		{nil, shots, 0.75, 0.125, false, "summed from shots without own goals"},
+		{stat("1.84", ""), shots, 0.75, 0.125, false, "unparsable statistic falls back to shots"},
+		{stat("n/a", "0.62"), nil, 0, 0, true, "no usable statistic and no shots"},
+		{nil, nil, 0, 0, true, "no data"},
+	}
+
+	for _, tt := range tests {
+		home, away := parseExpectedGoals(tt.stats, tt.shots)
+		if gotNil := home == nil || away == nil; gotNil != tt.wantNil {
+			t.Errorf("parseExpectedGoals() nil = %v; want %v - %s", gotNil, tt.wantNil, tt.desc)
+			continue
+		}
+		if !tt.wantNil && (*home != tt.wantHome || *away != tt.wantAway) {
+			t.Errorf("parseExpectedGoals() = %v, %v; want %v, %v - %s", *home, *away, tt.wantHome, tt.wantAway, tt.desc)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// fotmobPlayerData represents the response from the FotMob playerData endpoint
+type fotmobPlayerData struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PrimaryTeam *struct {
+		TeamID   int    `json:"teamId"`
+		TeamName string `json:"teamName"`
+	} `json:"primaryTeam,omitempty"`
+	PositionDescription *struct {
+		PrimaryPosition *struct {
+			Label string `json:"label"`
+		} `json:"primaryPosition,omitempty"`
+	} `json:"positionDescription,omitempty"`
+	// PlayerInformation holds profile facts such as "Shirt", "Age" and "Country"
+	PlayerInformation []struct {
+		Title string `json:"title"`
+		Value struct {
+			NumberValue *float64 `json:"numberValue,omitempty"`
+			Fallback    any      `json:"fallback,omitempty"` // Usually a string, sometimes a number
+		} `json:"value"`
+	} `json:"playerInformation"`
+	MainLeague *struct {
+		LeagueName string `json:"leagueName"`
+		Season     string `json:"season"`
+		Stats      []struct {
+			Title            string          `json:"title"`
+			LocalizedTitleID string          `json:"localizedTitleId"` // e.g., "goals", "matches_uppercase"
+			Value            json.RawMessage `json:"value"`            // Number, or string for some stats
+		} `json:"stats"`
+	} `json:"mainLeague,omitempty"`
+}
+
+// toAPIPlayerProfile converts the FotMob player data to the API format.
+func (p fotmobPlayerData) toAPIPlayerProfile() *api.PlayerProfile {
+	profile := &api.PlayerProfile{
+		ID:   p.ID,
+		Name: p.Name,
+	}
+
+	if p.PrimaryTeam != nil {
+		profile.Team = api.Team{ID: p.PrimaryTeam.TeamID, Name: p.PrimaryTeam.TeamName}
+	}
+	if p.PositionDescription != nil && p.PositionDescription.PrimaryPosition != nil {
+		profile.Position = p.PositionDescription.PrimaryPosition.Label
+	}
+
+	for _, info := range p.PlayerInformation {
+		switch strings.ToLower(info.Title) {
+		case "shirt":
+			if info.Value.NumberValue != nil {
+				profile.ShirtNumber = int(*info.Value.NumberValue)
+			}
+		case "age":
+			if info.Value.NumberValue != nil {
+				profile.Age = int(*info.Value.NumberValue)
+			}
+		case "country":
+			if s, ok := info.Value.Fallback.(string); ok {
+				profile.Country = s
+			}
+		}
+	}
+
+	if p.MainLeague != nil {
+		profile.Season.League = p.MainLeague.LeagueName
+		profile.Season.Season = p.MainLeague.Season
+		for _, stat := range p.MainLeague.Stats {
+			var value float64
+			if json.Unmarshal(stat.Value, &value) != nil {
+				continue
+			}
+			switch stat.LocalizedTitleID {
+			case "goals":
+				profile.Season.Goals = int(value)
+			case "assists":
+				profile.Season.Assists = int(value)
+			case "matches_uppercase":
+				profile.Season.Appearances = int(value)
+			case "rating":
+				profile.Season.Rating = fmt.Sprintf("%.2f", value)
+			}
+		}
+	}
+
+	return profile
+}
+
+// fotmobSearchSection represents a group of suggestions from the FotMob search endpoint
+type fotmobSearchSection struct {
+	Suggestions []struct {
+		Type     string      `json:"type"` // "player", "team", "league", ...
+		ID       json.Number `json:"id"`   // Sent as a string
+		Name     string      `json:"name"`
+		TeamName string      `json:"teamName,omitempty"`
+		IsCoach  bool        `json:"isCoach,omitempty"`
+	} `json:"suggestions"`
+}
+
+// PlayerProfile retrieves a player's profile and current season statistics.
+func (c *Client) PlayerProfile(ctx context.Context, playerID int) (*api.PlayerProfile, error) {
+	var response fotmobPlayerData
+	reqURL := fmt.Sprintf("%s/playerData?id=%d", c.baseURL, playerID)
+	if err := c.fetchJSON(ctx, reqURL, fmt.Sprintf("player %d", playerID), &response); err != nil {
+		return nil, err
+	}
+
+	if response.ID == 0 {
+		return nil, fmt.Errorf("no data available for player %d", playerID)
+	}
+
+	return response.toAPIPlayerProfile(), nil
+}
+
+// SearchPlayers finds players whose name matches the query.
+// Coaches are excluded. Results are returned in FotMob's relevance order.
+func (c *Client) SearchPlayers(ctx context.Context, query string) ([]api.PlayerSearchResult, error) {
+	var response []fotmobSearchSection
+	reqURL := fmt.Sprintf("%s/search/suggest?term=%s&lang=en", c.baseURL, url.QueryEscape(query))
+	if err := c.fetchJSON(ctx, reqURL, fmt.Sprintf("player search %q", query), &response); err != nil {
+		return nil, err
+	}
+
+	var results []api.PlayerSearchResult
+	for _, section := range response {
+		for _, s := range section.Suggestions {
+			if s.Type != "player" || s.IsCoach {
+				continue
+			}
+			results = append(results, api.PlayerSearchResult{
+				ID:       parseInt(s.ID.String()),
+				Name:     s.Name,
+				TeamName: s.TeamName,
+			})
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,102 @@
+package fotmob
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// lineupPayload wraps a home lineup and FotMob player statistics in a match details payload.
+func lineupPayload(starters, subs, playerStats string) string {
+	return `{
+		"general": {"matchId": "4506263", "homeTeam": {"id": 10, "name": "Arsenal"}, "awayTeam": {"id": 20, "name": "Chelsea"}},
+		"content": {
+			"playerStats": ` + playerStats + `,
+			"lineup": {"homeTeam": {"id": 10, "formation": "4-3-3", "starters": [` + starters + `], "subs": [` + subs + `]}}
+		}
+	}`
+}
+
+func TestMatchDetailsPlayerPerformance(t *testing.T) {
+	const saka = `{"id": 961995, "name": "Bukayo Saka", "shirtNumber": "7", "performance": {"rating": 8.1, "fantasyScore": "9"}}`
+	const rice = `{"id": 723437, "name": "Declan Rice", "shirtNumber": "41", "performance": {"rating": 7.2}}`
+	const nwaneri = `{"id": 1247021, "name": "Ethan Nwaneri", "shirtNumber": "53"}`
+	const sakaStats = `"961995": {"id": 961995, "stats": [
+		{"key": "top_stats", "stats": {
+			"Minutes played": {"key": "minutes_played", "stat": {"value": 90}},
+			"Goals": {"key": "goals", "stat": {"value": 1}},
+			"Assists": {"key": "assists", "stat": {"value": 2}},
+			"Accurate passes": {"key": "accurate_passes", "stat": {"value": 31, "total": 38}}
+		}},
+		{"key": "attack", "stats": {
+			"Total shots": {"key": "total_shots", "stat": {"value": 4}},
+			"Touches": {"key": "touches", "stat": {"value": 67}},
+			"Expected goals (xG)": {"key": "expected_goals", "stat": {"value": null}}
+		}}
+	]}`
+
+	tests := []struct {
+		subs        string
+		playerStats string
+		want        map[string]*api.PlayerPerformance // By player name, nil for none
+		desc        string
+	}{
+		{
+			subs:        nwaneri,
+			playerStats: `{` + sakaStats + `, "723437": {"id": 723437, "stats": [{"key": "top_stats", "stats": {"Minutes played": {"key": "minutes_played", "stat": {"value": 64}}}}]}}`,
+			want: map[string]*api.PlayerPerformance{
+				"Bukayo Saka":   {MinutesPlayed: 90, Goals: 1, Assists: 2, Shots: 4, AccuratePasses: 31, Passes: 38, FantasyScore: "9"},
+				"Declan Rice":   {MinutesPlayed: 64},
+				"Ethan Nwaneri": nil,
+			},
+			desc: "stats attached by player ID, unused substitute left untouched",
+		},
+		{
+			subs:        `{"id": 723437, "name": "Declan Rice", "shirtNumber": "41"}`,
+			playerStats: `{"723437": {"id": 723437, "stats": [{"key": "top_stats", "stats": {"Minutes played": {"key": "minutes_played", "stat": {"value": 26}}}}]}}`,
+			want: map[string]*api.PlayerPerformance{
+				"Bukayo Saka": {FantasyScore: "9"},
+				"Declan Rice": {MinutesPlayed: 26},
+			},
+			desc: "substitute stats attached",
+		},
+		{
+			playerStats: `null`,
+			want: map[string]*api.PlayerPerformance{
+				"Bukayo Saka": {FantasyScore: "9"},
+				"Declan Rice": nil,
+			},
+			desc: "stats unavailable",
+		},
+		{
+			playerStats: `[]`,
+			want: map[string]*api.PlayerPerformance{
+				"Bukayo Saka": {FantasyScore: "9"},
+				"Declan Rice": nil,
+			},
+			desc: "unexpected stats format ignored",
+		},
+	}
+
+	for _, tt := range tests {
+		starters := saka
+		if tt.subs == "" || tt.subs == nwaneri {
+			starters += ", " + rice
+		}
+		details := parseDetails(t, lineupPayload(starters, tt.subs, tt.playerStats))
+
+		got := make(map[string]*api.PlayerPerformance)
+		for _, p := range append(details.HomeStarting, details.HomeSubstitutes...) {
+			got[p.Name] = p.Performance
+		}
+		for name, want := range tt.want {
+			perf, ok := got[name]
+			switch {
+			case !ok:
+				t.Errorf("player %s missing from the lineup - %s", name, tt.desc)
+			case (perf == nil) != (want == nil) || (perf != nil && *perf != *want):
+				t.Errorf("%s performance = %+v; want %+v - %s", name, perf, want, tt.desc)
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestToAPIPlayerProfile(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    api.PlayerProfile
+		desc    string
+	}{
+		{
+			payload: `{
+				"id": 961995, "name": "Bukayo Saka",
+				"primaryTeam": {"teamId": 9825, "teamName": "Arsenal"},
+				"positionDescription": {"primaryPosition": {"label": "Right Winger"}},
+				"playerInformation": [
+					{"title": "Height", "value": {"numberValue": 178, "fallback": "178 cm"}},
+					{"title": "Shirt", "value": {"numberValue": 7, "fallback": 7}},
+					{"title": "Age", "value": {"numberValue": 24, "fallback": "24 years"}},
+					{"title": "Country", "value": {"fallback": "England"}}
+				],
+				"mainLeague": {"leagueName": "Premier League", "season": "2025/2026", "stats": [
+					{"title": "Goals", "localizedTitleId": "goals", "value": 9},
+					{"title": "Assists", "localizedTitleId": "assists", "value": 6},
+					{"title": "Matches", "localizedTitleId": "matches_uppercase", "value": 21},
+					{"title": "Rating", "localizedTitleId": "rating", "value": 7.456},
+					{"title": "Started", "localizedTitleId": "started", "value": 19}
+				]}
+			}`,
+			want: api.PlayerProfile{
+				ID: 961995, Name: "Bukayo Saka",
+				Team:     api.Team{ID: 9825, Name: "Arsenal"},
+				Position: "Right Winger", Country: "England", Age: 24, ShirtNumber: 7,
+				Season: api.PlayerSeasonStats{League: "Premier League", Season: "2025/2026", Appearances: 21, Goals: 9, Assists: 6, Rating: "7.46"},
+			},
+			desc: "full profile",
+		},
+		{
+			payload: `{
+				"id": 1247021, "name": "Ethan Nwaneri",
+				"playerInformation": [
+					{"title": "Shirt", "value": {"fallback": "53"}},
+					{"title": "Country", "value": {"numberValue": 1}}
+				],
+				"mainLeague": {"leagueName": "Premier League", "season": "2025/2026", "stats": [
+					{"title": "Goals", "localizedTitleId": "goals", "value": "-"},
+					{"title": "Matches", "localizedTitleId": "matches_uppercase", "value": 4}
+				]}
+			}`,
+			want: api.PlayerProfile{
+				ID: 1247021, Name: "Ethan Nwaneri",
+				Season: api.PlayerSeasonStats{League: "Premier League", Season: "2025/2026", Appearances: 4},
+			},
+			desc: "missing facts and non-numeric stats skipped",
+		},
+		{
+			payload: `{"id": 7, "name": "Unknown Player"}`,
+			want:    api.PlayerProfile{ID: 7, Name: "Unknown Player"},
+			desc:    "no team, position or league",
+		},
+	}
+
+	for _, tt := range tests {
+		var p fotmobPlayerData
+		if err := json.Unmarshal([]byte(tt.payload), &p); err != nil {
+			t.Fatalf("unmarshal payload: %v - %s", err, tt.desc)
+		}
+		if got := p.toAPIPlayerProfile(); *got != tt.want {
+			t.Errorf("toAPIPlayerProfile() = %+v; want %+v - %s", *got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestSearchPlayers(t *testing.T) {
+	const payload = `[
+		{"title": {"key": "players"}, "suggestions": [
+			{"type": "player", "id": "961995", "name": "Bukayo Saka", "teamName": "Arsenal"},
+			{"type": "player", "id": "30981", "name": "Mikel Arteta", "teamName": "Arsenal", "isCoach": true},
+			{"type": "player", "id": "1247021", "name": "Ethan Nwaneri"}
+		]},
+		{"title": {"key": "teams"}, "suggestions": [
+			{"type": "team", "id": "9825", "name": "Arsenal"}
+		]}
+	]`
+
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("term")
+		w.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	c := &Client{httpClient: srv.Client(), baseURL: srv.URL, rateLimiter: NewRateLimiter(0)}
+	got, err := c.SearchPlayers(context.Background(), "saka & co")
+	if err != nil {
+		t.Fatalf("SearchPlayers() error = %v", err)
+	}
+
+	want := []api.PlayerSearchResult{
+		{ID: 961995, Name: "Bukayo Saka", TeamName: "Arsenal"},
+		{ID: 1247021, Name: "Ethan Nwaneri"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("SearchPlayers() = %+v; want %+v", got, want)
+	}
+	if query != "saka & co" {
+		t.Errorf("search term = %q; want the escaped query", query)
+	}
+}
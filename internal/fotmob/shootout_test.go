@@ -0,0 +1,43 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseShootout(t *testing.T) {
+	tests := []struct {
+		raw       string
+		wantKicks int
+		wantHome  int
+		wantAway  int
+		wantScore bool
+		desc      string
+	}{
+		{``, 0, 0, 0, false, "no shootout"},
+		{`{"bad": true}`, 0, 0, 0, false, "not a list"},
+		{`[{"type":"Goal","isHome":true},{"type":"MissedPenalty","isHome":false},{"type":"Goal","isHome":true}]`, 3, 2, 0, true, "counted score"},
+		{`[{"type":"Goal","isHome":true,"penShootoutScore":[1,0]},{"type":"Goal","isHome":false,"penShootoutScore":[1,1]}]`, 2, 1, 1, true, "running score"},
+		{`[{"type":"Goal","isHome":true},{"type":7},{"type":"Goal","isHome":false}]`, 2, 1, 1, true, "malformed kick skipped"},
+		{`[{"type":"Goal","isHome":true},{"type":7,"penShootoutScore":[4,3]}]`, 1, 4, 3, true, "score from malformed last kick"},
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchDetails
+		m.Content.MatchFacts.Events.PenaltyShootoutEvents = json.RawMessage(tt.raw)
+
+		kicks := m.parseShootout()
+		home, away, ok := m.shootoutScore(kicks)
+		if len(kicks) != tt.wantKicks {
+			t.Errorf("parseShootout() = %d kicks; want %d - %s", len(kicks), tt.wantKicks, tt.desc)
+		}
+		if ok != tt.wantScore || home != tt.wantHome || away != tt.wantAway {
+			t.Errorf("shootoutScore() = %d-%d, %v; want %d-%d, %v - %s", home, away, ok, tt.wantHome, tt.wantAway, tt.wantScore, tt.desc)
+		}
+		for i, kick := range kicks {
+			if kick.Order != i+1 {
+				t.Errorf("kick %d Order = %d; want %d - %s", i, kick.Order, i+1, tt.desc)
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseShotCoordinates(t *testing.T) {
+	tests := []struct {
+		raw   string
+		wantX float64
+		wantY float64
+		desc  string
+	}{
+		{`{"shots": [{"teamId": 10, "x": 94.5, "y": 20}]}`, 94.5, 20, "attacking the far goal"},
+		{`{"shots": [{"teamId": 20, "x": 10.5, "y": 20}]}`, 94.5, 48, "attacking the near goal, mirrored"},
+		{`{"shots": [{"teamId": 20, "x": 52.5, "y": 34}]}`, 52.5, 34, "from the halfway line"},
+	}
+
+	for _, tt := range tests {
+		var m fotmobMatchDetails
+		m.General.HomeTeam.ID = 10
+		m.Content.Shotmap = json.RawMessage(tt.raw)
+
+		shots := m.parseShots()
+		if len(shots) != 1 {
+			t.Errorf("parseShots() = %d shots; want 1 - %s", len(shots), tt.desc)
+			continue
+		}
+		if shots[0].X != tt.wantX || shots[0].Y != tt.wantY {
+			t.Errorf("parseShots() at (%v, %v); want (%v, %v) - %s", shots[0].X, shots[0].Y, tt.wantX, tt.wantY, tt.desc)
+		}
+	}
+}
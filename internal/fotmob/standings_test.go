@@ -0,0 +1,142 @@
+package fotmob
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// standingsRow returns a FotMob table row; the zone colour is omitted when empty.
+func standingsRow(idx, id int, name, scores string, gd, pts int, qualColor string) string {
+	zone := ""
+	if qualColor != "" {
+		zone = fmt.Sprintf(`, "qualColor": %q`, qualColor)
+	}
+	return fmt.Sprintf(`{"id": %d, "name": %q, "shortName": %q, "idx": %d, "played": 10, "wins": 6, "draws": 2, "losses": 2, "scoresStr": %q, "goalConDiff": %d, "pts": %d%s}`,
+		id, name, name, idx, scores, gd, pts, zone)
+}
+
+// fetchTables serves a FotMob leagues payload and fetches its standings.
+func fetchTables(t *testing.T, payload string) ([]api.LeagueTableGroup, error) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(payload))
+	}))
+	defer srv.Close()
+
+	c := &Client{httpClient: srv.Client(), baseURL: srv.URL, rateLimiter: NewRateLimiter(0)}
+	return c.fetchLeagueTable(context.Background(), 47)
+}
+
+func TestFetchLeagueTable(t *testing.T) {
+	league := `{"table": [{"data": {
+		"leagueName": "Premier League",
+		"table": {
+			"all": [` + standingsRow(1, 9825, "Arsenal", "21-7", 14, 23, "#2AD572") + `, ` +
+		standingsRow(2, 8456, "Man City", "20-9", 11, 20, "#2ad572") + `, ` +
+		standingsRow(18, 8668, "Everton", "8-19", -11, 8, "#FF4646") + `, ` +
+		standingsRow(19, 9817, "Burnley", "7-20", -13, 7, "") + `],
+			"home": [` + standingsRow(1, 8668, "Everton", "6-3", 3, 10, "") + `]
+		},
+		"legend": [{"title": "Champions League", "color": "#2ad572"}, {"title": "Relegation", "color": "#ff4646"}],
+		"teamForm": {
+			"9825": [{"resultString": "L"}, {"resultString": "W"}, {"resultString": "W"}, {"resultString": "D"}, {"resultString": "W"}, {"resultString": "W"}],
+			"8668": [{"resultString": "L"}, {"resultString": "D"}]
+		}
+	}}]}`
+
+	groups, err := fetchTables(t, league)
+	if err != nil {
+		t.Fatalf("fetchLeagueTable() error = %v", err)
+	}
+	if len(groups) != 1 || groups[0].Name != "Premier League" {
+		t.Fatalf("fetchLeagueTable() = %+v; want the Premier League table", groups)
+	}
+
+	tests := []struct {
+		got  api.LeagueTableEntry
+		want api.LeagueTableEntry
+		desc string
+	}{
+		{
+			got: groups[0].All[0],
+			want: api.LeagueTableEntry{
+				Position: 1, Team: api.Team{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"},
+				Played: 10, Won: 6, Drawn: 2, Lost: 2, GoalsFor: 21, GoalsAgainst: 7, GoalDifference: 14, Points: 23,
+				Form: "WWDWW", Zone: "Champions League", ZoneColor: "#2AD572",
+			},
+			desc: "form trimmed to the last five results, zone from the legend",
+		},
+		{
+			got:  groups[0].All[1],
+			want: api.LeagueTableEntry{Position: 2, Team: api.Team{ID: 8456, Name: "Man City", ShortName: "Man City"}, Played: 10, Won: 6, Drawn: 2, Lost: 2, GoalsFor: 20, GoalsAgainst: 9, GoalDifference: 11, Points: 20, Zone: "Champions League", ZoneColor: "#2ad572"},
+			desc: "zone colours matched case-insensitively, no form",
+		},
+		{
+			got:  groups[0].All[3],
+			want: api.LeagueTableEntry{Position: 19, Team: api.Team{ID: 9817, Name: "Burnley", ShortName: "Burnley"}, Played: 10, Won: 6, Drawn: 2, Lost: 2, GoalsFor: 7, GoalsAgainst: 20, GoalDifference: -13, Points: 7},
+			desc: "team outside the zones",
+		},
+		{
+			got:  groups[0].Home[0],
+			want: api.LeagueTableEntry{Position: 1, Team: api.Team{ID: 8668, Name: "Everton", ShortName: "Everton"}, Played: 10, Won: 6, Drawn: 2, Lost: 2, GoalsFor: 6, GoalsAgainst: 3, GoalDifference: 3, Points: 10, Form: "LD", Zone: "Relegation", ZoneColor: "#FF4646"},
+			desc: "home table takes the zone of the overall table",
+		},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("entry = %+v; want %+v - %s", tt.got, tt.want, tt.desc)
+		}
+	}
+	if groups[0].Away != nil {
+		t.Errorf("away table = %+v; want nil when missing", groups[0].Away)
+	}
+}
+
+func TestFetchLeagueTableGroups(t *testing.T) {
+	tests := []struct {
+		payload   string
+		wantNames []string
+		wantErr   bool
+		desc      string
+	}{
+		{
+			payload: `{"table": [{"data": {"tables": [
+				{"leagueName": "Grp. A", "table": {"all": [` + standingsRow(1, 1, "Bayern", "9-2", 7, 9, "") + `]}},
+				{"leagueName": "Grp. B", "table": {"all": []}},
+				{"leagueName": "Grp. C", "table": {"all": [` + standingsRow(1, 2, "Inter", "5-1", 4, 7, "") + `]}}
+			]}}]}`,
+			wantNames: []string{"Grp. A", "Grp. C"},
+			desc:      "group tables, empty groups skipped",
+		},
+		{
+			payload: `{"table": [
+				{"data": {"leagueName": "Conference A", "table": {"all": [` + standingsRow(1, 3, "Toronto", "30-20", 10, 50, "") + `]}}},
+				{"data": {"leagueName": "Conference B", "table": {"all": [` + standingsRow(1, 4, "Seattle", "28-21", 7, 48, "") + `]}}}
+			]}`,
+			wantNames: []string{"Conference A", "Conference B"},
+			desc:      "one table per entry",
+		},
+		{payload: `{"table": []}`, wantErr: true, desc: "no tables"},
+		{payload: `{"table": `, wantErr: true, desc: "invalid response"},
+	}
+
+	for _, tt := range tests {
+		groups, err := fetchTables(t, tt.payload)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("fetchLeagueTable() error = %v; want error %v - %s", err, tt.wantErr, tt.desc)
+			continue
+		}
+		var names []string
+		for _, g := range groups {
+			names = append(names, g.Name)
+		}
+		if fmt.Sprint(names) != fmt.Sprint(tt.wantNames) {
+			t.Errorf("fetchLeagueTable() groups = %q; want %q - %s", names, tt.wantNames, tt.desc)
+		}
+	}
+}
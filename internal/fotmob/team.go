@@ -0,0 +1,226 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// formLength is the number of recent results included in a team's form string.
+const formLength = 5
+
+// fotmobTeamData represents the response from the FotMob teams endpoint
+type fotmobTeamData struct {
+	Details struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		ShortName string `json:"shortName"`
+		Country   string `json:"country"`
+	} `json:"details"`
+	Overview struct {
+		// Table holds the main league table the team plays in
+		Table []struct {
+			Data fotmobTable `json:"data"`
+		} `json:"table"`
+	} `json:"overview"`
+	Fixtures struct {
+		AllFixtures struct {
+			Fixtures []fotmobTeamFixture `json:"fixtures"`
+		} `json:"allFixtures"`
+	} `json:"fixtures"`
+	Squad json.RawMessage `json:"squad,omitempty"` // Object with grouped members, or null
+}
+
+// fotmobTeamFixture represents a single fixture or result in a team's schedule
+type fotmobTeamFixture struct {
+	ID         json.Number       `json:"id"` // Sent as a number or a string
+	Home       fotmobFixtureTeam `json:"home"`
+	Away       fotmobFixtureTeam `json:"away"`
+	Status     status            `json:"status"`
+	Tournament struct {
+		LeagueID int    `json:"leagueId"`
+		Name     string `json:"name"`
+	} `json:"tournament"`
+}
+
+// fotmobFixtureTeam represents a team in a fixture, with its score once the match has started
+type fotmobFixtureTeam struct {
+	ID    json.Number `json:"id"`
+	Name  string      `json:"name"`
+	Score *int        `json:"score,omitempty"`
+}
+
+// fotmobSquad represents a team's squad grouped by role ("keepers", "defenders", ...)
+type fotmobSquad struct {
+	Squad []struct {
+		Title   string `json:"title"`
+		Members []struct {
+			ID          int         `json:"id"`
+			Name        string      `json:"name"`
+			ShirtNumber json.Number `json:"shirtNumber,omitempty"`
+			Country     string      `json:"cname,omitempty"`
+			Age         int         `json:"age,omitempty"`
+		} `json:"members"`
+	} `json:"squad"`
+}
+
+// squadPositions maps FotMob squad group titles to positions.
+var squadPositions = map[string]string{
+	"coach":       "Coach",
+	"keepers":     "Goalkeeper",
+	"defenders":   "Defender",
+	"midfielders": "Midfielder",
+	"attackers":   "Forward",
+}
+
+// toAPIMatch converts a team fixture to api.Match.
+func (f fotmobTeamFixture) toAPIMatch() api.Match {
+	m := fotmobMatch{
+		ID:     f.ID.String(),
+		Home:   team{ID: f.Home.ID.String(), Name: f.Home.Name},
+		Away:   team{ID: f.Away.ID.String(), Name: f.Away.Name},
+		Status: f.Status,
+		League: league{ID: f.Tournament.LeagueID, Name: f.Tournament.Name},
+	}
+	match := m.toAPIMatch()
+
+	// Team fixtures carry scores on each side instead of in the status
+	if match.HomeScore == nil && f.Home.Score != nil && f.Away.Score != nil {
+		match.HomeScore = f.Home.Score
+		match.AwayScore = f.Away.Score
+	}
+	return match
+}
+
+// toAPITeamDetails converts the FotMob team data to the API format.
+func (t fotmobTeamData) toAPITeamDetails() *api.TeamDetails {
+	details := &api.TeamDetails{
+		Team: api.Team{
+			ID:        t.Details.ID,
+			Name:      t.Details.Name,
+			ShortName: t.Details.ShortName,
+		},
+		Country: t.Details.Country,
+	}
+
+	// League position from the main table
+	if len(t.Overview.Table) > 0 {
+		data := t.Overview.Table[0].Data
+		details.League = data.LeagueName
+		for _, row := range data.Table.All {
+			if row.ID == t.Details.ID {
+				details.LeaguePosition = row.Idx
+				break
+			}
+		}
+	}
+
+	// Split the schedule into results and upcoming fixtures
+	for _, f := range t.Fixtures.AllFixtures.Fixtures {
+		match := f.toAPIMatch()
+		switch match.Status {
+		case api.MatchStatusFinished:
+			details.Results = append(details.Results, match)
+		case api.MatchStatusNotStarted:
+			details.Fixtures = append(details.Fixtures, match)
+		}
+	}
+	sort.SliceStable(details.Results, func(i, j int) bool {
+		return matchTimeOf(details.Results[i]).After(matchTimeOf(details.Results[j]))
+	})
+	sort.SliceStable(details.Fixtures, func(i, j int) bool {
+		return matchTimeOf(details.Fixtures[i]).Before(matchTimeOf(details.Fixtures[j]))
+	})
+	details.Form = formString(t.Details.ID, details.Results)
+
+	details.Squad = t.parseSquad()
+	return details
+}
+
+// parseSquad extracts the squad members, keeping FotMob's grouping order.
+// Returns nil if squad data is unavailable.
+func (t fotmobTeamData) parseSquad() []api.SquadMember {
+	var squad fotmobSquad
+	if len(t.Squad) == 0 || json.Unmarshal(t.Squad, &squad) != nil {
+		return nil
+	}
+
+	var members []api.SquadMember
+	for _, group := range squad.Squad {
+		position, ok := squadPositions[strings.ToLower(group.Title)]
+		if !ok {
+			position = group.Title
+		}
+		for _, m := range group.Members {
+			members = append(members, api.SquadMember{
+				ID:       m.ID,
+				Name:     m.Name,
+				Number:   parseInt(m.ShirtNumber.String()),
+				Position: position,
+				Country:  m.Country,
+				Age:      m.Age,
+			})
+		}
+	}
+	return members
+}
+
+// formString builds the form of a team from its results (most recent first),
+// returning up to the last five results oldest first, e.g. "WWDLW".
+func formString(teamID int, results []api.Match) string {
+	var form []byte
+	for _, m := range results {
+		if len(form) == formLength {
+			break
+		}
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		goalsFor, goalsAgainst := *m.HomeScore, *m.AwayScore
+		if m.AwayTeam.ID == teamID {
+			goalsFor, goalsAgainst = goalsAgainst, goalsFor
+		}
+		switch {
+		case goalsFor > goalsAgainst:
+			form = append(form, 'W')
+		case goalsFor < goalsAgainst:
+			form = append(form, 'L')
+		default:
+			form = append(form, 'D')
+		}
+	}
+
+	// Reverse so the oldest result comes first
+	for i, j := 0, len(form)-1; i < j; i, j = i+1, j-1 {
+		form[i], form[j] = form[j], form[i]
+	}
+	return string(form)
+}
+
+// matchTimeOf returns the kickoff time of a match, or the zero time if unknown.
+func matchTimeOf(m api.Match) time.Time {
+	if m.MatchTime == nil {
+		return time.Time{}
+	}
+	return *m.MatchTime
+}
+
+// TeamDetails retrieves a team's overview: league position, form, fixtures and squad.
+func (c *Client) TeamDetails(ctx context.Context, teamID int) (*api.TeamDetails, error) {
+	var response fotmobTeamData
+	reqURL := fmt.Sprintf("%s/teams?id=%d", c.baseURL, teamID)
+	if err := c.fetchJSON(ctx, reqURL, fmt.Sprintf("team %d", teamID), &response); err != nil {
+		return nil, err
+	}
+
+	if response.Details.ID == 0 {
+		return nil, fmt.Errorf("no data available for team %d", teamID)
+	}
+
+	return response.toAPITeamDetails(), nil
+}
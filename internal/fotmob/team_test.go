@@ -0,0 +1,40 @@
+package fotmob
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestFormString(t *testing.T) {
+	result := func(homeID, awayID, homeScore, awayScore int) api.Match {
+		return api.Match{
+			HomeTeam:  api.Team{ID: homeID},
+			AwayTeam:  api.Team{ID: awayID},
+			HomeScore: &homeScore,
+			AwayScore: &awayScore,
+		}
+	}
+
+	tests := []struct {
+		results []api.Match
+		want    string
+		desc    string
+	}{
+		{nil, "", "no results"},
+		{[]api.Match{result(1, 2, 2, 0)}, "W", "home win"},
+		{[]api.Match{result(2, 1, 2, 0)}, "L", "away loss"},
+		{[]api.Match{result(2, 1, 1, 3), result(1, 2, 1, 1)}, "DW", "oldest first"},
+		{[]api.Match{
+			result(1, 2, 1, 0), result(1, 2, 0, 1), result(1, 2, 0, 0),
+			result(1, 2, 3, 0), result(1, 2, 2, 2), result(1, 2, 0, 4),
+		}, "DWDLW", "last five only"},
+	}
+
+	for _, tt := range tests {
+		got := formString(1, tt.results)
+		if got != tt.want {
+			t.Errorf("formString() = %q; want %q - %s", got, tt.want, tt.desc)
+		}
+	}
+}
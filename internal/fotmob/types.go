@@ -147,7 +147,7 @@ type fotmobMatchDetails struct {
 		MatchFacts struct {
 			Events struct {
 				Events                []fotmobEventDetail `json:"events"`
-				PenaltyShootoutEvents interface{}         `json:"penaltyShootoutEvents,omitempty"`
+				PenaltyShootoutEvents json.RawMessage     `json:"penaltyShootoutEvents,omitempty"`
 			} `json:"events"`
 			Highlights *struct {
 				URL    string `json:"url"`
@@ -171,7 +171,12 @@ type fotmobMatchDetails struct {
 				} `json:"all,omitempty"`
 			} `json:"periods,omitempty"`
 		} `json:"stats,omitempty"`
-		Lineup struct {
+		Momentum json.RawMessage `json:"momentum,omitempty"` // Object, or false when unavailable
+		Shotmap  json.RawMessage `json:"shotmap,omitempty"`  // Object, or null when unavailable
+		H2H      json.RawMessage `json:"h2h,omitempty"`      // Object, or null/false when unavailable
+		// PlayerStats maps player ID to per-match statistics (null when unavailable)
+		PlayerStats json.RawMessage `json:"playerStats,omitempty"`
+		Lineup      struct {
 			Lineup   []fotmobTeamLineup `json:"lineup"`
 			HomeTeam *fotmobNewLineup   `json:"homeTeam,omitempty"`
 			AwayTeam *fotmobNewLineup   `json:"awayTeam,omitempty"`
@@ -179,6 +184,108 @@ type fotmobMatchDetails struct {
 	} `json:"content"`
 }
 
+// fotmobMomentum represents the momentum series from FotMob
+type fotmobMomentum struct {
+	Main struct {
+		Data []struct {
+			Minute float64 `json:"minute"`
+			Value  float64 `json:"value"`
+		} `json:"data"`
+	} `json:"main"`
+}
+
+// fotmobShotmap represents the shot map from FotMob
+type fotmobShotmap struct {
+	Shots []json.RawMessage `json:"shots"` // Decoded one by one, see fotmobShot
+}
+
+// fotmobShot represents a single shot from FotMob
+type fotmobShot struct {
+	ID                    int      `json:"id"`
+	EventType             string   `json:"eventType"` // "Goal", "AttemptSaved", "Miss", "Post"
+	TeamID                int      `json:"teamId"`
+	PlayerID              int      `json:"playerId"`
+	PlayerName            string   `json:"playerName"`
+	Min                   int      `json:"min"`
+	MinAdded              *int     `json:"minAdded,omitempty"`
+	ExpectedGoals         *float64 `json:"expectedGoals,omitempty"`
+	ExpectedGoalsOnTarget *float64 `json:"expectedGoalsOnTarget,omitempty"`
+	IsBlocked             bool     `json:"isBlocked"`
+	IsOnTarget            bool     `json:"isOnTarget"`
+	IsOwnGoal             bool     `json:"isOwnGoal"`
+	Situation             string   `json:"situation,omitempty"`
+	ShotType              string   `json:"shotType,omitempty"`
+	X                     float64  `json:"x"`
+	Y                     float64  `json:"y"`
+}
+
+// fotmobH2H represents the head-to-head history from FotMob
+type fotmobH2H struct {
+	Summary []int            `json:"summary"` // [home wins, draws, away wins] for the current match's teams
+	Matches []fotmobH2HMatch `json:"matches"`
+}
+
+// fotmobH2HMatch represents a previous meeting between the two teams
+type fotmobH2HMatch struct {
+	Time struct {
+		UTCTime string `json:"utcTime"`
+	} `json:"time"`
+	League struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"league"`
+	Home   fotmobFixtureTeam `json:"home"`
+	Away   fotmobFixtureTeam `json:"away"`
+	Status struct {
+		Finished  *bool  `json:"finished"`
+		Cancelled *bool  `json:"cancelled"`
+		ScoreStr  string `json:"scoreStr"` // e.g., "2 - 1"
+	} `json:"status"`
+}
+
+// fotmobPlayerStats represents a player's match statistics from FotMob,
+// grouped in sections ("Top stats", "Attack", ...) of stats keyed by title
+type fotmobPlayerStats struct {
+	ID    int `json:"id"`
+	Stats []struct {
+		Key   string `json:"key"`
+		Stats map[string]struct {
+			Key  string `json:"key"` // e.g., "minutes_played", "accurate_passes"
+			Stat struct {
+				Value *float64 `json:"value"`
+				Total *float64 `json:"total,omitempty"` // Set for fraction stats such as passes
+			} `json:"stat"`
+		} `json:"stats"`
+	} `json:"stats"`
+}
+
+// toAPIPerformance converts FotMob player statistics to the API format.
+func (s fotmobPlayerStats) toAPIPerformance(perf *api.PlayerPerformance) {
+	for _, section := range s.Stats {
+		for _, item := range section.Stats {
+			if item.Stat.Value == nil {
+				continue
+			}
+			value := int(*item.Stat.Value)
+			switch item.Key {
+			case "minutes_played":
+				perf.MinutesPlayed = value
+			case "goals":
+				perf.Goals = value
+			case "assists":
+				perf.Assists = value
+			case "total_shots":
+				perf.Shots = value
+			case "accurate_passes":
+				perf.AccuratePasses = value
+				if item.Stat.Total != nil {
+					perf.Passes = int(*item.Stat.Total)
+				}
+			}
+		}
+	}
+}
+
 // fotmobStatCategory represents a category of match statistics
 type fotmobStatCategory struct {
 	Title string           `json:"title"`
@@ -267,6 +374,66 @@ type fotmobEventDetail struct {
 	AssistStr      string `json:"assistStr,omitempty"`
 	AssistInput    string `json:"assistInput,omitempty"`
 	AssistPlayerID *int   `json:"assistPlayerId,omitempty"`
+	VAR            *struct {
+		Decision struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"decision"`
+	} `json:"VAR,omitempty"` // VAR review outcome, on "VAR" events and reviewed goals
+}
+
+// kind maps the FotMob event type to an api.EventKind.
+// cancelled reports whether a VAR review overturned the goal.
+func (e fotmobEventDetail) kind(cancelled bool) api.EventKind {
+	switch strings.ToLower(e.Type) {
+	case "goal":
+		if cancelled {
+			return api.EventKindDisallowedGoal
+		}
+		return api.EventKindGoal
+	case "var":
+		if cancelled {
+			return api.EventKindDisallowedGoal
+		}
+		return api.EventKindVAR
+	case "missedpenalty":
+		return api.EventKindMissedPenalty
+	case "card":
+		return api.EventKindCard
+	case "substitution":
+		return api.EventKindSubstitution
+	case "addedtime":
+		return api.EventKindAddedTime
+	default:
+		return api.EventKindOther
+	}
+}
+
+// cardColor maps a FotMob card string ("Yellow", "Red", "YellowRed") to an api.CardColor.
+func cardColor(card string) api.CardColor {
+	switch strings.ToLower(strings.ReplaceAll(card, " ", "")) {
+	case "red", "redcard":
+		return api.CardRed
+	case "yellowred", "secondyellow":
+		return api.CardSecondYellow
+	default:
+		return api.CardYellow
+	}
+}
+
+// varDecision returns the VAR decision text of an event and whether it cancelled a goal.
+func (e fotmobEventDetail) varDecision() (string, bool) {
+	if e.VAR == nil {
+		return "", false
+	}
+	decision := e.VAR.Decision.Value
+	if decision == "" {
+		decision = e.VAR.Decision.Key
+	}
+	key := strings.ToLower(e.VAR.Decision.Key + " " + e.VAR.Decision.Value)
+	cancelled := strings.Contains(key, "goal") &&
+		(strings.Contains(key, "cancel") || strings.Contains(key, "disallow") || strings.Contains(key, "not_awarded") || strings.Contains(key, "not awarded"))
+	return decision, cancelled
 }
 
 // toAPIMatchDetails converts fotmobMatchDetails to api.MatchDetails
@@ -404,6 +571,12 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	// Parse match statistics
 	details.Statistics = m.parseStatistics()
 
+	// Parse momentum series and shots (with xG)
+	details.Momentum = m.parseMomentum()
+	details.HeadToHead = m.parseHeadToHead()
+	details.Shots = m.parseShots()
+	details.HomeXG, details.AwayXG = parseExpectedGoals(details.Statistics, details.Shots)
+
 	// Parse lineup information
 	m.parseLineups(details)
 
@@ -416,26 +589,13 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		}
 	}
 
-	// Parse penalty shootout results if available
-	if m.Content.MatchFacts.Events.PenaltyShootoutEvents != nil {
-		if penaltyEvents, ok := m.Content.MatchFacts.Events.PenaltyShootoutEvents.([]interface{}); ok && len(penaltyEvents) > 0 {
-			// Get the final penalty scores from the last event
-			lastEvent := penaltyEvents[len(penaltyEvents)-1]
-			if eventMap, ok := lastEvent.(map[string]interface{}); ok {
-				if penShootoutScore, exists := eventMap["penShootoutScore"].([]interface{}); exists && len(penShootoutScore) >= 2 {
-					if homeScoreFloat, ok := penShootoutScore[0].(float64); ok {
-						homeScore := int(homeScoreFloat)
-						if awayScoreFloat, ok := penShootoutScore[1].(float64); ok {
-							awayScore := int(awayScoreFloat)
-							details.Penalties = &struct {
-								Home *int `json:"home,omitempty"`
-								Away *int `json:"away,omitempty"`
-							}{Home: &homeScore, Away: &awayScore}
-						}
-					}
-				}
-			}
-		}
+	// Parse penalty shootout kicks and final score if available
+	details.Shootout = m.parseShootout()
+	if homeScore, awayScore, ok := m.shootoutScore(details.Shootout); ok {
+		details.Penalties = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: &homeScore, Away: &awayScore}
 	}
 
 	// Convert events from content.matchFacts.events
@@ -446,15 +606,16 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 			continue
 		}
 
-		// Normalize event type to lowercase for consistent matching
-		eventType := strings.ToLower(e.Type)
-
+		decision, cancelled := e.varDecision()
 		event := api.MatchEvent{
 			ID:        e.EventID,
 			Minute:    e.Time,
-			Type:      eventType,
+			Kind:      e.kind(cancelled),
 			Timestamp: time.Now(),
 		}
+		if decision != "" {
+			event.Decision = &decision
+		}
 
 		// Set display minute - use TimeStr if available (for stoppage time), otherwise format base minute
 		if timeStrVal, ok := e.TimeStr.(string); ok && timeStrVal != "" {
@@ -467,7 +628,7 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 			event.DisplayMinute = fmt.Sprintf("%d'", e.Time)
 		}
 
-		// Extract player name
+		// Extract player name and ID
 		playerName := ""
 		if e.Player != nil && e.Player.Name != "" {
 			playerName = e.Player.Name
@@ -479,49 +640,53 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 		if playerName != "" {
 			event.Player = &playerName
 		}
+		if e.Player != nil && e.Player.ID != 0 {
+			event.PlayerID = e.Player.ID
+		} else if e.PlayerID != nil {
+			event.PlayerID = *e.PlayerID
+		}
 
 		// Extract assist
 		if e.AssistInput != "" {
 			event.Assist = &e.AssistInput
 		}
 
-		// Extract event type details
-		eventTypeDetail := ""
-		if e.Type == "Card" && e.Card != "" {
-			eventTypeDetail = strings.ToLower(e.Card)
-		} else if e.Type == "Substitution" && len(e.Swap) >= 2 {
+		// Extract kind-specific details
+		switch event.Kind {
+		case api.EventKindGoal, api.EventKindDisallowedGoal:
+			event.IsOwnGoal = e.OwnGoal != nil && *e.OwnGoal
+			event.IsPenalty = e.IsPenalty != nil && *e.IsPenalty
+		case api.EventKindMissedPenalty:
+			event.IsPenalty = true
+		case api.EventKindCard:
+			event.Card = cardColor(e.Card)
+		case api.EventKindSubstitution:
 			// Substitution: swap[0] is player coming IN, swap[1] is player going OUT
-			// Store player out in Player field, player in in Assist field (repurposed)
-			playerIn := e.Swap[0].Name
-			playerOut := e.Swap[1].Name
-			event.Player = &playerOut
-			event.Assist = &playerIn // Repurpose Assist to store player coming in
-			eventTypeDetail = "sub"
-		} else if strings.ToLower(e.Type) == "addedtime" {
+			if len(e.Swap) >= 2 {
+				event.PlayerIn = &api.EventPlayer{ID: parseInt(e.Swap[0].ID), Name: e.Swap[0].Name}
+				event.PlayerOut = &api.EventPlayer{ID: parseInt(e.Swap[1].ID), Name: e.Swap[1].Name}
+			}
+			event.Player = nil
+			event.PlayerID = 0
+		case api.EventKindAddedTime:
 			// Added time event - extract minutes from available fields
-			eventTypeDetail = "addedtime"
-			// Try multiple sources for added time info
-			var addedTimeStr string
 			// Check timeStr (can be string or int)
 			if timeStrVal, ok := e.TimeStr.(string); ok && timeStrVal != "" {
-				addedTimeStr = timeStrVal
+				event.Detail = timeStrVal
 			} else if timeStrInt, ok := e.TimeStr.(float64); ok {
-				addedTimeStr = strconv.Itoa(int(timeStrInt))
+				event.Detail = strconv.Itoa(int(timeStrInt))
 			}
 			// Check nameStr which sometimes contains the added time
-			if addedTimeStr == "" && e.NameStr != "" {
-				addedTimeStr = e.NameStr
+			if event.Detail == "" && e.NameStr != "" {
+				event.Detail = e.NameStr
 			}
 			// Check if there's a player field with the info
-			if addedTimeStr == "" && e.Player != nil && e.Player.Name != "" {
-				addedTimeStr = e.Player.Name
-			}
-			if addedTimeStr != "" {
-				event.Player = &addedTimeStr
+			if event.Detail == "" && e.Player != nil && e.Player.Name != "" {
+				event.Detail = e.Player.Name
 			}
-		}
-		if eventTypeDetail != "" {
-			event.EventType = &eventTypeDetail
+			event.Player = nil
+		case api.EventKindOther:
+			event.Detail = e.Type
 		}
 
 		// Set team based on isHome flag
@@ -553,6 +718,290 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	return details
 }
 
+// fotmobPenaltyKick represents a single penalty shootout kick from FotMob
+type fotmobPenaltyKick struct {
+	Type   string `json:"type"` // "Goal" or "MissedPenalty"
+	IsHome bool   `json:"isHome"`
+	Player *struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"player,omitempty"`
+	PlayerID         *int   `json:"playerId,omitempty"`
+	NameStr          string `json:"nameStr,omitempty"`
+	FullName         string `json:"fullName,omitempty"`
+	PenShootoutScore []int  `json:"penShootoutScore,omitempty"` // Running score [home, away]
+}
+
+// shootoutEvents returns the raw penalty shootout events, or nil if there are none
+// or they are not a list.
+func (m fotmobMatchDetails) shootoutEvents() []json.RawMessage {
+	raw := m.Content.MatchFacts.Events.PenaltyShootoutEvents
+	if len(raw) == 0 {
+		return nil
+	}
+	var events []json.RawMessage
+	if err := json.Unmarshal(raw, &events); err != nil {
+		return nil
+	}
+	return events
+}
+
+// parseShootout extracts the penalty shootout kicks in order.
+// Kicks that can't be parsed are skipped; returns nil if the match had no shootout.
+func (m fotmobMatchDetails) parseShootout() []api.PenaltyKick {
+	events := m.shootoutEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	shootout := make([]api.PenaltyKick, 0, len(events))
+	homeScore, awayScore := 0, 0
+	for _, event := range events {
+		var k fotmobPenaltyKick
+		if err := json.Unmarshal(event, &k); err != nil {
+			continue
+		}
+
+		kick := api.PenaltyKick{
+			Order:  len(shootout) + 1,
+			IsHome: k.IsHome,
+			Scored: strings.EqualFold(k.Type, "Goal"),
+		}
+
+		if k.Player != nil && k.Player.Name != "" {
+			kick.Player = k.Player.Name
+		} else if k.FullName != "" {
+			kick.Player = k.FullName
+		} else {
+			kick.Player = k.NameStr
+		}
+		if k.Player != nil && k.Player.ID != 0 {
+			kick.PlayerID = k.Player.ID
+		} else if k.PlayerID != nil {
+			kick.PlayerID = *k.PlayerID
+		}
+
+		// Prefer FotMob's running score, fall back to counting scored kicks
+		if kick.Scored {
+			if kick.IsHome {
+				homeScore++
+			} else {
+				awayScore++
+			}
+		}
+		if len(k.PenShootoutScore) >= 2 {
+			homeScore, awayScore = k.PenShootoutScore[0], k.PenShootoutScore[1]
+		}
+		kick.HomeScore = homeScore
+		kick.AwayScore = awayScore
+
+		shootout = append(shootout, kick)
+	}
+
+	if len(shootout) == 0 {
+		return nil
+	}
+	return shootout
+}
+
+// shootoutScore returns the final penalty shootout score: FotMob's running score on
+// the last shootout event, even when that kick couldn't be parsed, or else the score
+// after the last parsed kick.
+func (m fotmobMatchDetails) shootoutScore(kicks []api.PenaltyKick) (home, away int, ok bool) {
+	if events := m.shootoutEvents(); len(events) > 0 {
+		var last struct {
+			PenShootoutScore []int `json:"penShootoutScore"`
+		}
+		if json.Unmarshal(events[len(events)-1], &last) == nil && len(last.PenShootoutScore) >= 2 {
+			return last.PenShootoutScore[0], last.PenShootoutScore[1], true
+		}
+	}
+	if n := len(kicks); n > 0 {
+		return kicks[n-1].HomeScore, kicks[n-1].AwayScore, true
+	}
+	return 0, 0, false
+}
+
+// parseMomentum extracts the per-minute momentum series.
+// Returns nil if momentum data is unavailable or empty.
+func (m fotmobMatchDetails) parseMomentum() []api.MomentumPoint {
+	var momentum fotmobMomentum
+	if len(m.Content.Momentum) == 0 || json.Unmarshal(m.Content.Momentum, &momentum) != nil {
+		return nil
+	}
+	if len(momentum.Main.Data) == 0 {
+		return nil
+	}
+
+	points := make([]api.MomentumPoint, 0, len(momentum.Main.Data))
+	for _, d := range momentum.Main.Data {
+		points = append(points, api.MomentumPoint{Minute: d.Minute, Value: d.Value})
+	}
+	return points
+}
+
+// parseHeadToHead extracts previous meetings between the two teams, most recent first.
+// Returns nil if head-to-head data is unavailable.
+func (m fotmobMatchDetails) parseHeadToHead() *api.HeadToHead {
+	var h2h fotmobH2H
+	if len(m.Content.H2H) == 0 || json.Unmarshal(m.Content.H2H, &h2h) != nil {
+		return nil
+	}
+	if len(h2h.Matches) == 0 && len(h2h.Summary) == 0 {
+		return nil
+	}
+
+	result := &api.HeadToHead{}
+	for _, hm := range h2h.Matches {
+		if hm.Status.Cancelled != nil && *hm.Status.Cancelled {
+			continue
+		}
+		// Only finished meetings belong to the history (upcoming ones include this match)
+		if hm.Status.Finished == nil || !*hm.Status.Finished {
+			continue
+		}
+
+		fixture := fotmobTeamFixture{
+			Home:   hm.Home,
+			Away:   hm.Away,
+			Status: status{UTCTime: hm.Time.UTCTime, Finished: hm.Status.Finished},
+		}
+		fixture.Tournament.LeagueID = hm.League.ID
+		fixture.Tournament.Name = hm.League.Name
+		match := fixture.toAPIMatch()
+
+		var homeScore, awayScore int
+		if _, err := fmt.Sscanf(hm.Status.ScoreStr, "%d - %d", &homeScore, &awayScore); err == nil {
+			match.HomeScore = &homeScore
+			match.AwayScore = &awayScore
+		}
+		result.Matches = append(result.Matches, match)
+	}
+	sort.SliceStable(result.Matches, func(i, j int) bool {
+		return matchTimeOf(result.Matches[i]).After(matchTimeOf(result.Matches[j]))
+	})
+
+	if len(h2h.Summary) == 3 {
+		result.HomeWins, result.Draws, result.AwayWins = h2h.Summary[0], h2h.Summary[1], h2h.Summary[2]
+	} else {
+		// Fall back to counting the listed meetings
+		homeID := m.General.HomeTeam.ID
+		for _, match := range result.Matches {
+			if match.HomeScore == nil || match.AwayScore == nil {
+				continue
+			}
+			goalsFor, goalsAgainst := *match.HomeScore, *match.AwayScore
+			if match.HomeTeam.ID != homeID {
+				goalsFor, goalsAgainst = goalsAgainst, goalsFor
+			}
+			switch {
+			case goalsFor > goalsAgainst:
+				result.HomeWins++
+			case goalsFor < goalsAgainst:
+				result.AwayWins++
+			default:
+				result.Draws++
+			}
+		}
+	}
+
+	return result
+}
+
+// parseShots extracts all shots with their xG values, in chronological order.
+// Shots that can't be parsed are skipped; returns nil if shot data is unavailable.
+func (m fotmobMatchDetails) parseShots() []api.Shot {
+	var shotmap fotmobShotmap
+	if len(m.Content.Shotmap) == 0 || json.Unmarshal(m.Content.Shotmap, &shotmap) != nil {
+		return nil
+	}
+
+	shots := make([]api.Shot, 0, len(shotmap.Shots))
+	for _, raw := range shotmap.Shots {
+		var s fotmobShot
+		if err := json.Unmarshal(raw, &s); err != nil {
+			continue
+		}
+		shot := api.Shot{
+			ID:        s.ID,
+			Minute:    s.Min,
+			IsHome:    s.TeamID == m.General.HomeTeam.ID,
+			Player:    s.PlayerName,
+			PlayerID:  s.PlayerID,
+			XGOT:      s.ExpectedGoalsOnTarget,
+			Situation: s.Situation,
+			ShotType:  s.ShotType,
+			IsOwnGoal: s.IsOwnGoal,
+		}
+		if s.MinAdded != nil {
+			shot.MinuteAdded = *s.MinAdded
+		}
+		if s.ExpectedGoals != nil {
+			shot.XG = *s.ExpectedGoals
+		}
+
+		// Normalize coordinates so every shot attacks the goal at X=105
+		shot.X, shot.Y = s.X, s.Y
+		if shot.X < api.PitchLength/2 {
+			shot.X = api.PitchLength - shot.X
+			shot.Y = api.PitchWidth - shot.Y
+		}
+
+		switch {
+		case s.EventType == "Goal":
+			shot.Outcome = api.ShotGoal
+		case s.IsBlocked:
+			shot.Outcome = api.ShotBlocked
+		case s.IsOnTarget || s.EventType == "AttemptSaved":
+			shot.Outcome = api.ShotOnTarget
+		default:
+			shot.Outcome = api.ShotOff
+		}
+
+		shots = append(shots, shot)
+	}
+
+	sort.SliceStable(shots, func(i, j int) bool {
+		if shots[i].Minute != shots[j].Minute {
+			return shots[i].Minute < shots[j].Minute
+		}
+		return shots[i].MinuteAdded < shots[j].MinuteAdded
+	})
+
+	return shots
+}
+
+// parseExpectedGoals returns the xG totals for both teams.
+// Uses the "expected_goals" statistic, falling back to summing shot xG.
+func parseExpectedGoals(stats []api.MatchStatistic, shots []api.Shot) (*float64, *float64) {
+	for _, stat := range stats {
+		if stat.Key != "expected_goals" {
+			continue
+		}
+		home, errHome := strconv.ParseFloat(stat.HomeValue, 64)
+		away, errAway := strconv.ParseFloat(stat.AwayValue, 64)
+		if errHome == nil && errAway == nil {
+			return &home, &away
+		}
+	}
+
+	if len(shots) == 0 {
+		return nil, nil
+	}
+	var home, away float64
+	for _, shot := range shots {
+		if shot.IsOwnGoal {
+			continue // Own goals carry no xG for either side
+		}
+		if shot.IsHome {
+			home += shot.XG
+		} else {
+			away += shot.XG
+		}
+	}
+	return &home, &away
+}
+
 // parseStatistics extracts match statistics from FotMob response
 func (m fotmobMatchDetails) parseStatistics() []api.MatchStatistic {
 	var stats []api.MatchStatistic
@@ -670,6 +1119,30 @@ func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 			}
 		}
 	}
+
+	m.attachPlayerPerformance(details)
+}
+
+// attachPlayerPerformance adds per-match statistics to every lineup player.
+// Players without statistics (e.g. unused substitutes) are left untouched.
+func (m fotmobMatchDetails) attachPlayerPerformance(details *api.MatchDetails) {
+	var stats map[string]fotmobPlayerStats
+	if len(m.Content.PlayerStats) == 0 || json.Unmarshal(m.Content.PlayerStats, &stats) != nil {
+		return
+	}
+
+	for _, players := range [][]api.PlayerInfo{details.HomeStarting, details.AwayStarting, details.HomeSubstitutes, details.AwaySubstitutes} {
+		for i := range players {
+			s, ok := stats[strconv.Itoa(players[i].ID)]
+			if !ok {
+				continue
+			}
+			if players[i].Performance == nil {
+				players[i].Performance = &api.PlayerPerformance{}
+			}
+			s.toAPIPerformance(players[i].Performance)
+		}
+	}
 }
 
 // convertNewLineupPlayers converts new format player info to API format
@@ -686,6 +1159,9 @@ func convertNewLineupPlayers(players []fotmobNewPlayerInfo) []api.PlayerInfo {
 		}
 		if p.Performance != nil {
 			player.Rating = string(p.Performance.Rating)
+			if p.Performance.FantasyScore != "" {
+				player.Performance = &api.PlayerPerformance{FantasyScore: p.Performance.FantasyScore}
+			}
 		}
 		result = append(result, player)
 	}
@@ -703,9 +1179,78 @@ type fotmobTableRow struct {
 	Wins        int    `json:"wins"`
 	Draws       int    `json:"draws"`
 	Losses      int    `json:"losses"`
-	ScoresStr   string `json:"scoresStr"`   // e.g., "42-17"
-	GoalConDiff int    `json:"goalConDiff"` // Goal difference
-	Pts         int    `json:"pts"`         // Points
+	ScoresStr   string `json:"scoresStr"`           // e.g., "42-17"
+	GoalConDiff int    `json:"goalConDiff"`         // Goal difference
+	Pts         int    `json:"pts"`                 // Points
+	QualColor   string `json:"qualColor,omitempty"` // Zone colour, e.g., "#2AD572"
+}
+
+// fotmobTable represents a single standings table from FotMob, with its zones and team form
+type fotmobTable struct {
+	LeagueName string `json:"leagueName"`
+	Table      struct {
+		All  []fotmobTableRow `json:"all"`
+		Home []fotmobTableRow `json:"home"`
+		Away []fotmobTableRow `json:"away"`
+	} `json:"table"`
+	Legend []struct {
+		Title string `json:"title"`
+		Color string `json:"color"`
+	} `json:"legend"`
+	// TeamForm maps team IDs to their recent results, oldest first
+	TeamForm map[string][]struct {
+		ResultString string `json:"resultString"` // "W", "D" or "L"
+	} `json:"teamForm"`
+}
+
+// toAPITableGroup converts a fotmobTable to api.LeagueTableGroup.
+// Form and zones are attached to every ranking so home and away views show them too.
+func (t fotmobTable) toAPITableGroup() api.LeagueTableGroup {
+	zones := make(map[string]string, len(t.Legend))
+	for _, l := range t.Legend {
+		zones[strings.ToUpper(l.Color)] = l.Title
+	}
+
+	// Zones come from the overall table; home and away rows carry no qualification colour
+	zoneColors := make(map[int]string, len(t.Table.All))
+	for _, row := range t.Table.All {
+		zoneColors[row.ID] = row.QualColor
+	}
+
+	convert := func(rows []fotmobTableRow) []api.LeagueTableEntry {
+		if len(rows) == 0 {
+			return nil
+		}
+		entries := make([]api.LeagueTableEntry, 0, len(rows))
+		for _, row := range rows {
+			entry := row.toAPITableEntry()
+			entry.ZoneColor = zoneColors[row.ID]
+			entry.Zone = zones[strings.ToUpper(entry.ZoneColor)]
+			entry.Form = t.teamForm(row.ID)
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	return api.LeagueTableGroup{
+		Name: t.LeagueName,
+		All:  convert(t.Table.All),
+		Home: convert(t.Table.Home),
+		Away: convert(t.Table.Away),
+	}
+}
+
+// teamForm returns up to the last five results of a team, oldest first.
+func (t fotmobTable) teamForm(teamID int) string {
+	var form strings.Builder
+	for _, r := range t.TeamForm[strconv.Itoa(teamID)] {
+		form.WriteString(r.ResultString)
+	}
+	s := form.String()
+	if len(s) > formLength {
+		s = s[len(s)-formLength:]
+	}
+	return s
 }
 
 // toAPITableEntry converts fotmobTableRow to api.LeagueTableEntry
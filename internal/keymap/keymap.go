@@ -0,0 +1,327 @@
+// Package keymap defines golazo's key bindings: the built-in presets, overrides
+// from the config file, and the help text generated from the active bindings.
+package keymap
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// Built-in preset names.
+const (
+	Vim    = "vim"    // hjkl and arrows (the original bindings)
+	Emacs  = "emacs"  // ctrl+p/n/b/f and arrows
+	Arrows = "arrows" // Arrows only, leaving h/j/k/l unbound
+)
+
+// Default is the preset used unless another is configured.
+const Default = Vim
+
+// KeyMap holds the bindings of every app action. Dialogs move, select and close
+// with these too, and keep their own keys for the rest.
+type KeyMap struct {
+	// Everywhere
+	Up      key.Binding
+	Down    key.Binding
+	Left    key.Binding // Previous tab or date range
+	Right   key.Binding // Next tab or date range
+	Select  key.Binding
+	Back    key.Binding
+	Quit    key.Binding
+	Help    key.Binding
+	Filter  key.Binding
+	Palette key.Binding // Command palette
+
+	// Match lists
+	Refresh       key.Binding
+	Announcements key.Binding // Live matches, accessible mode only
+	Grid          key.Binding // Live matches side by side
+
+	// Finished matches, with the details panel focused
+	FocusDetails  key.Binding
+	Standings     key.Binding
+	LeagueLeaders key.Binding
+	Bracket       key.Binding
+	Formations    key.Binding
+	HeadToHead    key.Binding
+	Statistics    key.Binding
+	Momentum      key.Binding
+	ShotMap       key.Binding
+	Players       key.Binding
+	HomeTeam      key.Binding
+	AwayTeam      key.Binding
+
+	// Settings
+	Toggle  key.Binding
+	Profile key.Binding
+	Theme   key.Binding
+}
+
+// Scopes group actions by where they apply: a key may only be bound once among
+// actions that can be triggered from the same view (see overlaps).
+const (
+	scopeGlobal   = "global"
+	scopeLists    = "lists"
+	scopeDetails  = "details"
+	scopeSettings = "settings"
+)
+
+// action describes a bindable action: its name in config.yaml, help text, scope,
+// default keys and where it is stored in a KeyMap.
+type action struct {
+	name  string
+	desc  string
+	scope string
+	keys  []string
+	field func(*KeyMap) *key.Binding
+}
+
+// actions lists every action in help order. Navigation keys are filled in by the preset.
+var actions = []action{
+	{"up", "up", scopeGlobal, nil, func(k *KeyMap) *key.Binding { return &k.Up }},
+	{"down", "down", scopeGlobal, nil, func(k *KeyMap) *key.Binding { return &k.Down }},
+	{"left", "previous tab/range", scopeGlobal, nil, func(k *KeyMap) *key.Binding { return &k.Left }},
+	{"right", "next tab/range", scopeGlobal, nil, func(k *KeyMap) *key.Binding { return &k.Right }},
+	{"select", "select", scopeGlobal, []string{"enter"}, func(k *KeyMap) *key.Binding { return &k.Select }},
+	{"back", "back", scopeGlobal, []string{"esc"}, func(k *KeyMap) *key.Binding { return &k.Back }},
+	{"quit", "quit", scopeGlobal, []string{"q", "ctrl+c"}, func(k *KeyMap) *key.Binding { return &k.Quit }},
+	{"help", "help", scopeGlobal, []string{"?"}, func(k *KeyMap) *key.Binding { return &k.Help }},
+	{"filter", "filter", scopeGlobal, []string{"/"}, func(k *KeyMap) *key.Binding { return &k.Filter }},
+	{"palette", "command palette", scopeGlobal, []string{":", "ctrl+p"}, func(k *KeyMap) *key.Binding { return &k.Palette }},
+
+	{"refresh", "refresh details", scopeLists, []string{"r"}, func(k *KeyMap) *key.Binding { return &k.Refresh }},
+	{"announcements", "announcements", scopeLists, []string{"a"}, func(k *KeyMap) *key.Binding { return &k.Announcements }},
+	{"grid", "grid of live matches", scopeLists, []string{"v"}, func(k *KeyMap) *key.Binding { return &k.Grid }},
+	{"focus_details", "focus details", scopeLists, []string{"tab"}, func(k *KeyMap) *key.Binding { return &k.FocusDetails }},
+
+	{"standings", "standings", scopeDetails, []string{"s"}, func(k *KeyMap) *key.Binding { return &k.Standings }},
+	{"league_leaders", "league leaders", scopeDetails, []string{"g"}, func(k *KeyMap) *key.Binding { return &k.LeagueLeaders }},
+	{"bracket", "bracket", scopeDetails, []string{"b"}, func(k *KeyMap) *key.Binding { return &k.Bracket }},
+	{"formations", "formations", scopeDetails, []string{"f"}, func(k *KeyMap) *key.Binding { return &k.Formations }},
+	{"head_to_head", "head-to-head", scopeDetails, []string{"H"}, func(k *KeyMap) *key.Binding { return &k.HeadToHead }},
+	{"statistics", "all statistics", scopeDetails, []string{"x"}, func(k *KeyMap) *key.Binding { return &k.Statistics }},
+	{"momentum", "momentum/xG", scopeDetails, []string{"m"}, func(k *KeyMap) *key.Binding { return &k.Momentum }},
+	{"shot_map", "shot map", scopeDetails, []string{"p"}, func(k *KeyMap) *key.Binding { return &k.ShotMap }},
+	{"players", "players", scopeDetails, []string{"e"}, func(k *KeyMap) *key.Binding { return &k.Players }},
+	{"home_team", "home team", scopeDetails, []string{"t"}, func(k *KeyMap) *key.Binding { return &k.HomeTeam }},
+	{"away_team", "away team", scopeDetails, []string{"T"}, func(k *KeyMap) *key.Binding { return &k.AwayTeam }},
+
+	{"toggle", "toggle", scopeSettings, []string{" "}, func(k *KeyMap) *key.Binding { return &k.Toggle }},
+	{"profile", "profile", scopeSettings, []string{"p"}, func(k *KeyMap) *key.Binding { return &k.Profile }},
+	{"theme", "theme", scopeSettings, []string{"t"}, func(k *KeyMap) *key.Binding { return &k.Theme }},
+}
+
+// presets holds each preset's navigation keys, and other keys moved out of their
+// way. The first key of each action is the one shown in help.
+var presets = map[string]map[string][]string{
+	Vim: {
+		"up":    {"up", "k"},
+		"down":  {"down", "j"},
+		"left":  {"left", "h"},
+		"right": {"right", "l"},
+	},
+	Emacs: {
+		"up":      {"up", "ctrl+p"},
+		"down":    {"down", "ctrl+n"},
+		"left":    {"left", "ctrl+b"},
+		"right":   {"right", "ctrl+f"},
+		"back":    {"esc", "ctrl+g"},
+		"palette": {":", "alt+x"}, // ctrl+p moves up
+	},
+	Arrows: {
+		"up":    {"up"},
+		"down":  {"down"},
+		"left":  {"left"},
+		"right": {"right"},
+	},
+}
+
+// Presets returns the names of the built-in presets.
+func Presets() []string {
+	return []string{Vim, Emacs, Arrows}
+}
+
+// Actions returns the names of the bindable actions, as used in config.yaml.
+func Actions() []string {
+	names := make([]string, len(actions))
+	for i, a := range actions {
+		names[i] = a.name
+	}
+	return names
+}
+
+// New builds a key map from a preset and per-action overrides, which replace the
+// preset's keys. Reports unknown presets and actions, and keys bound twice in a scope.
+func New(preset string, overrides map[string][]string) (KeyMap, error) {
+	presetKeys, ok := presets[preset]
+	if !ok {
+		return KeyMap{}, fmt.Errorf("unknown key preset %q (available: %s)", preset, strings.Join(Presets(), ", "))
+	}
+
+	var unknown []string
+	for name := range overrides {
+		if !slices.ContainsFunc(actions, func(a action) bool { return a.name == name }) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return KeyMap{}, fmt.Errorf("unknown key actions %s (available: %s)", strings.Join(unknown, ", "), strings.Join(Actions(), ", "))
+	}
+
+	var km KeyMap
+	owners := make(map[string]map[string]string) // scope -> key -> action
+	for _, a := range actions {
+		keys := a.keys
+		if k, ok := presetKeys[a.name]; ok {
+			keys = k
+		}
+		if k, ok := overrides[a.name]; ok {
+			if len(k) == 0 {
+				return KeyMap{}, fmt.Errorf("key action %s: no keys given", a.name)
+			}
+			keys = make([]string, len(k))
+			for i, name := range k {
+				if name == "space" {
+					name = " " // Space is written out in config.yaml
+				}
+				keys[i] = name
+			}
+		}
+		if a.name == "quit" && !slices.Contains(keys, "ctrl+c") {
+			keys = append(slices.Clone(keys), "ctrl+c") // Never lose the way out
+		}
+
+		for _, k := range keys {
+			for scope, bound := range owners {
+				if !overlaps(scope, a.scope) {
+					continue
+				}
+				if other, ok := bound[k]; ok {
+					return KeyMap{}, fmt.Errorf("key %q is bound to both %s and %s", k, other, a.name)
+				}
+			}
+			if owners[a.scope] == nil {
+				owners[a.scope] = make(map[string]string)
+			}
+			owners[a.scope][k] = a.name
+		}
+
+		*a.field(&km) = key.NewBinding(key.WithKeys(keys...), key.WithHelp(KeyName(keys[0]), a.desc))
+	}
+	return km, nil
+}
+
+// overlaps reports whether actions of two scopes can be triggered from the same
+// view. Details actions share the stats view with the match list actions.
+func overlaps(a, b string) bool {
+	lists := func(s string) bool { return s == scopeLists || s == scopeDetails }
+	return a == b || a == scopeGlobal || b == scopeGlobal || (lists(a) && lists(b))
+}
+
+// KeyName returns how a key is shown in help, e.g. "↑" for "up" and "Enter" for "enter".
+func KeyName(k string) string {
+	switch k {
+	case "up":
+		return "↑"
+	case "down":
+		return "↓"
+	case "left":
+		return "←"
+	case "right":
+		return "→"
+	case "enter":
+		return "Enter"
+	case "esc":
+		return "Esc"
+	case "tab":
+		return "Tab"
+	case " ":
+		return "Space"
+	}
+	return k
+}
+
+// AllKeys returns every key of a binding as shown in help, e.g. "↑/k".
+func AllKeys(b key.Binding) string {
+	names := make([]string, len(b.Keys()))
+	for i, k := range b.Keys() {
+		names[i] = KeyName(k)
+	}
+	return strings.Join(names, "/")
+}
+
+// Pair returns a single help entry for two bindings, e.g. "↑/↓: navigate".
+func Pair(a, b key.Binding, desc string) key.Binding {
+	return key.NewBinding(
+		key.WithKeys(append(slices.Clone(a.Keys()), b.Keys()...)...),
+		key.WithHelp(a.Help().Key+"/"+b.Help().Key, desc),
+	)
+}
+
+// Describe returns b with another help description, for actions whose meaning
+// depends on the view (e.g. Tab focusing or unfocusing the details panel).
+func Describe(b key.Binding, desc string) key.Binding {
+	b.SetHelp(b.Help().Key, desc)
+	return b
+}
+
+// HelpLine renders bindings as a help bar, e.g. "↑/↓: navigate  Enter: select".
+// Disabled bindings are left out.
+func HelpLine(bindings ...key.Binding) string {
+	parts := make([]string, 0, len(bindings))
+	for _, b := range bindings {
+		if b.Enabled() {
+			parts = append(parts, b.Help().Key+": "+b.Help().Desc)
+		}
+	}
+	return strings.Join(parts, "  ")
+}
+
+// HelpGroup is a titled section of the full help.
+type HelpGroup struct {
+	Title    string
+	Bindings []key.Binding // Help keys list every key of the binding
+}
+
+// FullHelp returns every enabled binding of k grouped by where it applies.
+func (k KeyMap) FullHelp() []HelpGroup {
+	titles := map[string]string{
+		scopeGlobal:   "General",
+		scopeLists:    "Match lists",
+		scopeDetails:  "Match details (Finished Matches, details focused)",
+		scopeSettings: "Settings",
+	}
+
+	var groups []HelpGroup
+	for _, a := range actions {
+		if len(groups) == 0 || groups[len(groups)-1].Title != titles[a.scope] {
+			groups = append(groups, HelpGroup{Title: titles[a.scope]})
+		}
+		b := *a.field(&k)
+		if !b.Enabled() {
+			continue
+		}
+		b.SetHelp(AllKeys(b), b.Help().Desc)
+		group := &groups[len(groups)-1]
+		group.Bindings = append(group.Bindings, b)
+	}
+	return groups
+}
+
+// current is the active key map.
+var current, _ = New(Default, nil)
+
+// Current returns the active key map.
+func Current() KeyMap {
+	return current
+}
+
+// Set makes km the active key map. Call at startup, before the app runs.
+func Set(km KeyMap) {
+	current = km
+}
@@ -0,0 +1,53 @@
+package keymap
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		preset    string
+		overrides map[string][]string
+		binding   func(KeyMap) key.Binding // Binding checked when New succeeds
+		wantKeys  []string
+		wantErr   string // Substring of the error, empty if none
+		desc      string
+	}{
+		{Vim, nil, func(k KeyMap) key.Binding { return k.Down }, []string{"down", "j"}, "", "vim navigation"},
+		{Emacs, nil, func(k KeyMap) key.Binding { return k.Up }, []string{"up", "ctrl+p"}, "", "emacs navigation"},
+		{Emacs, nil, func(k KeyMap) key.Binding { return k.Palette }, []string{":", "alt+x"}, "", "emacs moves the palette off ctrl+p"},
+		{Arrows, nil, func(k KeyMap) key.Binding { return k.Left }, []string{"left"}, "", "arrows only"},
+		{Vim, map[string][]string{"refresh": {"R"}}, func(k KeyMap) key.Binding { return k.Refresh }, []string{"R"}, "", "override replaces keys"},
+		{Vim, map[string][]string{"toggle": {"space", "x"}}, func(k KeyMap) key.Binding { return k.Toggle }, []string{" ", "x"}, "", "space written out"},
+		{Vim, map[string][]string{"quit": {"Q"}}, func(k KeyMap) key.Binding { return k.Quit }, []string{"Q", "ctrl+c"}, "", "quit keeps ctrl+c"},
+		{Arrows, map[string][]string{"refresh": {"j"}}, func(k KeyMap) key.Binding { return k.Refresh }, []string{"j"}, "", "key freed by the preset"},
+		{Vim, map[string][]string{"profile": {"s"}}, func(k KeyMap) key.Binding { return k.Profile }, []string{"s"}, "", "same key in separate scopes"},
+		{"helix", nil, nil, nil, "unknown key preset", "unknown preset"},
+		{Vim, map[string][]string{"jump": {"J"}, "dance": {"D"}}, nil, nil, "unknown key actions dance, jump", "unknown actions"},
+		{Vim, map[string][]string{"refresh": {}}, nil, nil, "refresh: no keys given", "empty override"},
+		{Vim, map[string][]string{"refresh": {"j"}}, nil, nil, `key "j" is bound to both down and refresh`, "conflict with a global key"},
+		{Vim, map[string][]string{"standings": {"r"}}, nil, nil, `key "r" is bound to both refresh and standings`, "conflict between lists and details"},
+		{Vim, map[string][]string{"theme": {"q"}}, nil, nil, `key "q" is bound to both quit and theme`, "conflict in settings"},
+	}
+
+	for _, tt := range tests {
+		km, err := New(tt.preset, tt.overrides)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("New() error = %v; want %q - %s", err, tt.wantErr, tt.desc)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("New() error = %v - %s", err, tt.desc)
+			continue
+		}
+		if got := tt.binding(km).Keys(); !slices.Equal(got, tt.wantKeys) {
+			t.Errorf("New() keys = %q; want %q - %s", got, tt.wantKeys, tt.desc)
+		}
+	}
+}
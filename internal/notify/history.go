@@ -0,0 +1,314 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const (
+	historyFileName = "notified_events.json"
+	// HistoryTTL defines how long per-match notification records are kept.
+	// Long enough to survive restarts during a match, short enough to keep the file small.
+	HistoryTTL = 2 * 24 * time.Hour // 2 days
+)
+
+// NotifiedGoal is a goal event that has already triggered a notification.
+type NotifiedGoal struct {
+	EventID int    `json:"event_id"`
+	Minute  int    `json:"minute"`
+	Player  string `json:"player,omitempty"`
+	IsHome  bool   `json:"is_home"`
+	// Reason is the VAR decision for a cancelled goal, when known (not persisted).
+	Reason string `json:"-"`
+}
+
+// MatchRecord holds the notification state of a single match.
+type MatchRecord struct {
+	HomeScore int            `json:"home_score"`
+	AwayScore int            `json:"away_score"`
+	Goals     []NotifiedGoal `json:"goals"`
+	Kicks     int            `json:"kicks,omitempty"` // Penalty shootout kicks already notified
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// historySchema is the versioned format of the notification history.
+// Bump Version and add a migration when the on-disk format changes.
+var historySchema = data.FileSchema{
+	Name:    "notification history",
+	Format:  data.FormatJSON,
+	Version: 1,
+}
+
+// historyData is the on-disk format of the notification history.
+type historyData struct {
+	Version int                 `json:"version"`
+	Matches map[int]MatchRecord `json:"matches"`
+}
+
+// GoalChanges describes the goal notifications required after a match update.
+type GoalChanges struct {
+	// NewGoals are goal events not yet notified for this match.
+	NewGoals []api.MatchEvent
+	// Disallowed are previously notified goals that were cancelled (e.g. by VAR).
+	Disallowed []NotifiedGoal
+	// NewKicks are penalty shootout kicks not yet notified for this match.
+	NewKicks []api.PenaltyKick
+}
+
+// History provides persistent storage of already-notified goal events per match.
+// It prevents duplicate notifications across restarts and match switches.
+type History struct {
+	mu       sync.RWMutex
+	matches  map[int]MatchRecord
+	filePath string
+}
+
+// NewHistory creates a new notification history, loading existing data from disk.
+func NewHistory() (*History, error) {
+	dir, err := data.ConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("get config dir: %w", err)
+	}
+
+	h := &History{
+		matches:  make(map[int]MatchRecord),
+		filePath: filepath.Join(dir, historyFileName),
+	}
+
+	// Load existing history from disk (start with empty history on errors, moving corrupt files aside)
+	if err := h.load(); err != nil {
+		historySchema.Recover(err)
+	}
+
+	// Clean expired entries on startup to keep file size manageable
+	_ = h.CleanExpired()
+
+	return h, nil
+}
+
+// Record returns the notification record for a match, if one exists.
+func (h *History) Record(matchID int) (MatchRecord, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	rec, ok := h.matches[matchID]
+	return rec, ok
+}
+
+// Update compares match details against the stored record and returns the
+// goals and shootout kicks that need a notification. The record is updated and
+// persisted when the goals, shootout kicks or score changed.
+// The first time a match is seen its goals are recorded without notifying,
+// so opening a match never replays goals that were scored earlier.
+func (h *History) Update(details *api.MatchDetails) (GoalChanges, error) {
+	var changes GoalChanges
+	if details == nil {
+		return changes, nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	homeScore, awayScore := scoreOf(details)
+	goals := goalEvents(details)
+
+	rec, seen := h.matches[details.ID]
+	changed := !seen || rec.HomeScore != homeScore || rec.AwayScore != awayScore || rec.Kicks != len(details.Shootout)
+	if !seen {
+		rec = MatchRecord{}
+		for _, event := range goals {
+			rec.Goals = append(rec.Goals, toNotifiedGoal(event, details))
+		}
+	} else {
+		notified := make(map[int]bool, len(rec.Goals))
+		for _, g := range rec.Goals {
+			notified[g.EventID] = true
+		}
+		present := make(map[int]bool, len(goals))
+		for _, event := range goals {
+			present[event.ID] = true
+		}
+
+		// A lower score than last recorded means a goal was cancelled.
+		// Attribute it to the most recent notified goal that disappeared from the events.
+		var disallowed []NotifiedGoal
+		disallowed = append(disallowed, cancelledGoals(rec, present, true, rec.HomeScore-homeScore)...)
+		disallowed = append(disallowed, cancelledGoals(rec, present, false, rec.AwayScore-awayScore)...)
+		if len(disallowed) > 0 {
+			attachReasons(disallowed, details)
+			removed := make(map[int]bool, len(disallowed))
+			for _, g := range disallowed {
+				removed[g.EventID] = true
+			}
+			kept := rec.Goals[:0]
+			for _, g := range rec.Goals {
+				if !removed[g.EventID] {
+					kept = append(kept, g)
+				}
+			}
+			rec.Goals = kept
+			changes.Disallowed = disallowed
+		}
+
+		for _, event := range goals {
+			if notified[event.ID] {
+				continue
+			}
+			changes.NewGoals = append(changes.NewGoals, event)
+			rec.Goals = append(rec.Goals, toNotifiedGoal(event, details))
+		}
+
+		if len(details.Shootout) > rec.Kicks {
+			changes.NewKicks = details.Shootout[rec.Kicks:]
+		}
+		changed = changed || len(changes.NewGoals) > 0 || len(changes.Disallowed) > 0
+	}
+
+	// Only save (and refresh the expiry) if something changed, as every poll of a live match ends up here
+	if !changed {
+		return changes, nil
+	}
+	rec.Kicks = len(details.Shootout)
+	rec.HomeScore = homeScore
+	rec.AwayScore = awayScore
+	rec.UpdatedAt = time.Now()
+	h.matches[details.ID] = rec
+
+	return changes, h.saveLocked()
+}
+
+// CleanExpired removes records that have not been updated within HistoryTTL.
+func (h *History) CleanExpired() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	cleaned := false
+	for id, rec := range h.matches {
+		if time.Since(rec.UpdatedAt) > HistoryTTL {
+			delete(h.matches, id)
+			cleaned = true
+		}
+	}
+
+	// Only save if something was cleaned
+	if cleaned {
+		return h.saveLocked()
+	}
+	return nil
+}
+
+// load reads the history from disk.
+func (h *History) load() error {
+	var hd historyData
+	if err := historySchema.Load(h.filePath, &hd); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil // No history file yet, that's fine
+		}
+		return err
+	}
+
+	for id, rec := range hd.Matches {
+		h.matches[id] = rec
+	}
+	return nil
+}
+
+// saveLocked persists the history to disk (must hold write lock).
+func (h *History) saveLocked() error {
+	if err := historySchema.Save(h.filePath, historyData{
+		Version: historySchema.Version,
+		Matches: h.matches,
+	}); err != nil {
+		return fmt.Errorf("write history file: %w", err)
+	}
+
+	return nil
+}
+
+// cancelledGoals picks up to count notified goals for one side that are no longer
+// present in the match events, most recent first. If the cancelled goal cannot be
+// identified, a placeholder without player details is returned.
+func cancelledGoals(rec MatchRecord, present map[int]bool, isHome bool, count int) []NotifiedGoal {
+	if count <= 0 {
+		return nil
+	}
+
+	var missing []NotifiedGoal
+	for _, g := range rec.Goals {
+		if g.IsHome == isHome && !present[g.EventID] {
+			missing = append(missing, g)
+		}
+	}
+	sort.SliceStable(missing, func(i, j int) bool {
+		return missing[i].Minute > missing[j].Minute
+	})
+
+	if len(missing) > count {
+		missing = missing[:count]
+	}
+	for len(missing) < count {
+		missing = append(missing, NotifiedGoal{EventID: -1, IsHome: isHome})
+	}
+	return missing
+}
+
+// attachReasons fills in the VAR decision of cancelled goals from the
+// matching disallowed-goal events (same event ID, or same side and minute).
+func attachReasons(goals []NotifiedGoal, details *api.MatchDetails) {
+	for i := range goals {
+		for _, event := range details.Events {
+			if event.Kind != api.EventKindDisallowedGoal || event.Decision == nil {
+				continue
+			}
+			isHome := event.Team.ID == details.HomeTeam.ID
+			if event.ID == goals[i].EventID || (isHome == goals[i].IsHome && event.Minute == goals[i].Minute) {
+				goals[i].Reason = *event.Decision
+				break
+			}
+		}
+	}
+}
+
+// goalEvents returns the goal events of a match.
+func goalEvents(details *api.MatchDetails) []api.MatchEvent {
+	var goals []api.MatchEvent
+	for _, event := range details.Events {
+		if event.IsGoal() {
+			goals = append(goals, event)
+		}
+	}
+	return goals
+}
+
+// toNotifiedGoal converts a goal event into its history representation.
+func toNotifiedGoal(event api.MatchEvent, details *api.MatchDetails) NotifiedGoal {
+	g := NotifiedGoal{
+		EventID: event.ID,
+		Minute:  event.Minute,
+		IsHome:  event.Team.ID == details.HomeTeam.ID,
+	}
+	if event.Player != nil {
+		g.Player = *event.Player
+	}
+	return g
+}
+
+// scoreOf returns the current score of a match, treating missing values as 0.
+func scoreOf(details *api.MatchDetails) (int, int) {
+	homeScore, awayScore := 0, 0
+	if details.HomeScore != nil {
+		homeScore = *details.HomeScore
+	}
+	if details.AwayScore != nil {
+		awayScore = *details.AwayScore
+	}
+	return homeScore, awayScore
+}
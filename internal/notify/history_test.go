@@ -0,0 +1,193 @@
+package notify
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+const (
+	testHome = 10
+	testAway = 20
+)
+
+func goal(id, minute, teamID int, player string) api.MatchEvent {
+	return api.MatchEvent{ID: id, Minute: minute, Kind: api.EventKindGoal, Team: api.Team{ID: teamID}, Player: &player}
+}
+
+func matchWith(homeScore, awayScore int, goals ...api.MatchEvent) *api.MatchDetails {
+	return &api.MatchDetails{
+		Match: api.Match{
+			ID:        1,
+			HomeTeam:  api.Team{ID: testHome},
+			AwayTeam:  api.Team{ID: testAway},
+			HomeScore: &homeScore,
+			AwayScore: &awayScore,
+		},
+		Events: goals,
+	}
+}
+
+func newTestHistory(path string) *History {
+	h := &History{matches: make(map[int]MatchRecord), filePath: path}
+	if err := h.load(); err != nil {
+		panic(err)
+	}
+	return h
+}
+
+func TestHistoryUpdate(t *testing.T) {
+	first := goal(100, 12, testHome, "Saka")
+	second := goal(101, 30, testAway, "Salah")
+	third := goal(102, 55, testHome, "Rice")
+
+	tests := []struct {
+		polls          []*api.MatchDetails // Earlier polls, then the one checked
+		restart        bool                // Reload the history from disk before the last poll
+		wantNew        []int               // Event IDs of new goals on the last poll
+		wantDisallowed []int               // Event IDs of disallowed goals on the last poll
+		desc           string
+	}{
+		{
+			polls: []*api.MatchDetails{matchWith(1, 0, first)},
+			desc:  "first sighting records without notifying",
+		},
+		{
+			polls:   []*api.MatchDetails{matchWith(0, 0), matchWith(1, 0, first)},
+			wantNew: []int{100},
+			desc:    "new goal",
+		},
+		{
+			polls: []*api.MatchDetails{matchWith(0, 0), matchWith(1, 0, first), matchWith(1, 0, first)},
+			desc:  "repeated poll",
+		},
+		{
+			polls:   []*api.MatchDetails{matchWith(1, 0, first), matchWith(1, 1, first, second), matchWith(2, 1, first, second, third)},
+			wantNew: []int{102},
+			desc:    "only the latest goal",
+		},
+		{
+			polls:          []*api.MatchDetails{matchWith(0, 0), matchWith(2, 0, first, third), matchWith(1, 0, first)},
+			wantDisallowed: []int{102},
+			desc:           "score drop with the goal removed",
+		},
+		{
+			polls:          []*api.MatchDetails{matchWith(0, 0), matchWith(1, 0, first), matchWith(0, 0, first)},
+			wantDisallowed: []int{-1},
+			desc:           "score drop with the goal still listed",
+		},
+		{
+			polls:   []*api.MatchDetails{matchWith(1, 0, first), matchWith(1, 1, first, second)},
+			restart: true,
+			wantNew: []int{101},
+			desc:    "restart with persisted history",
+		},
+		{
+			polls:   []*api.MatchDetails{matchWith(1, 0, first), matchWith(1, 0, first)},
+			restart: true,
+			desc:    "restart does not replay goals",
+		},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), historyFileName)
+		h := newTestHistory(path)
+
+		var changes GoalChanges
+		for i, details := range tt.polls {
+			if tt.restart && i == len(tt.polls)-1 {
+				h = newTestHistory(path)
+			}
+			var err error
+			changes, err = h.Update(details)
+			if err != nil {
+				t.Fatalf("Update() error = %v - %s", err, tt.desc)
+			}
+		}
+
+		var gotNew, gotDisallowed []int
+		for _, event := range changes.NewGoals {
+			gotNew = append(gotNew, event.ID)
+		}
+		for _, g := range changes.Disallowed {
+			gotDisallowed = append(gotDisallowed, g.EventID)
+		}
+		if !slices.Equal(gotNew, tt.wantNew) {
+			t.Errorf("new goals = %v; want %v - %s", gotNew, tt.wantNew, tt.desc)
+		}
+		if !slices.Equal(gotDisallowed, tt.wantDisallowed) {
+			t.Errorf("disallowed goals = %v; want %v - %s", gotDisallowed, tt.wantDisallowed, tt.desc)
+		}
+	}
+}
+
+func TestCancelledGoals(t *testing.T) {
+	rec := MatchRecord{Goals: []NotifiedGoal{
+		{EventID: 1, Minute: 10, IsHome: true},
+		{EventID: 2, Minute: 40, IsHome: true},
+		{EventID: 3, Minute: 25, IsHome: false},
+	}}
+
+	tests := []struct {
+		present map[int]bool
+		isHome  bool
+		count   int
+		want    []int
+		desc    string
+	}{
+		{map[int]bool{1: true, 2: true, 3: true}, true, 0, nil, "no score drop"},
+		{map[int]bool{1: true, 3: true}, true, 1, []int{2}, "missing goal"},
+		{map[int]bool{3: true}, true, 1, []int{2}, "most recent first"},
+		{map[int]bool{3: true}, true, 2, []int{2, 1}, "two goals"},
+		{map[int]bool{1: true, 2: true, 3: true}, true, 1, []int{-1}, "placeholder when none missing"},
+		{map[int]bool{1: true, 2: true}, false, 1, []int{3}, "away side only"},
+	}
+
+	for _, tt := range tests {
+		var got []int
+		for _, g := range cancelledGoals(rec, tt.present, tt.isHome, tt.count) {
+			got = append(got, g.EventID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("cancelledGoals() = %v; want %v - %s", got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestHistoryUpdateSaves(t *testing.T) {
+	first := goal(100, 12, testHome, "Saka")
+
+	tests := []struct {
+		polls    []*api.MatchDetails // Earlier polls, then the one checked
+		wantSave bool
+		desc     string
+	}{
+		{[]*api.MatchDetails{matchWith(0, 0)}, true, "first sighting"},
+		{[]*api.MatchDetails{matchWith(0, 0), matchWith(0, 0)}, false, "nothing changed"},
+		{[]*api.MatchDetails{matchWith(0, 0), matchWith(1, 0, first)}, true, "new goal"},
+		{[]*api.MatchDetails{matchWith(1, 0, first), matchWith(1, 0, first)}, false, "same goal polled again"},
+		{[]*api.MatchDetails{matchWith(1, 0, first), matchWith(0, 0)}, true, "goal disallowed"},
+	}
+
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), historyFileName)
+		h := newTestHistory(path)
+
+		for i, details := range tt.polls {
+			if i == len(tt.polls)-1 {
+				os.Remove(path)
+			}
+			if _, err := h.Update(details); err != nil {
+				t.Fatalf("Update() error = %v - %s", err, tt.desc)
+			}
+		}
+
+		_, err := os.Stat(path)
+		if saved := err == nil; saved != tt.wantSave {
+			t.Errorf("Update() saved = %v; want %v - %s", saved, tt.wantSave, tt.desc)
+		}
+	}
+}
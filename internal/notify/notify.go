@@ -47,6 +47,10 @@ func getIconPath() string {
 type Notifier interface {
 	// Goal sends a notification for a new goal event.
 	Goal(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+	// GoalDisallowed sends a notification for a previously notified goal that was cancelled.
+	GoalDisallowed(goal NotifiedGoal, homeTeam, awayTeam api.Team, homeScore, awayScore int) error
+	// PenaltyKick sends a notification for a penalty shootout kick.
+	PenaltyKick(kick api.PenaltyKick, homeTeam, awayTeam api.Team) error
 }
 
 // DesktopNotifier implements Notifier using native desktop notifications.
@@ -96,6 +100,100 @@ func (n *DesktopNotifier) Goal(event api.MatchEvent, homeTeam, awayTeam api.Team
 	return nil
 }
 
+// GoalDisallowed sends a desktop notification for a goal cancelled after it was notified,
+// typically after a VAR review. Plays a terminal beep like Goal.
+func (n *DesktopNotifier) GoalDisallowed(goal NotifiedGoal, homeTeam, awayTeam api.Team, homeScore, awayScore int) error {
+	if !n.enabled {
+		return nil
+	}
+
+	_, _ = os.Stderr.WriteString("\a")
+
+	title := constants.NotificationTitleGoalDisallowed
+	message := formatDisallowedMessage(goal, homeTeam, awayTeam, homeScore, awayScore)
+
+	_ = beeep.Notify(title, message, getIconPath())
+
+	return nil
+}
+
+// formatDisallowedMessage creates the notification message for a cancelled goal.
+// Format: "Scorer 34' [Team]\nHome 1 - 1 Away"
+func formatDisallowedMessage(goal NotifiedGoal, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
+	team := awayTeam
+	if goal.IsHome {
+		team = homeTeam
+	}
+	teamName := team.ShortName
+	if teamName == "" {
+		teamName = team.Name
+	}
+
+	// Scorer is unknown when the cancelled goal could not be matched to an event
+	header := fmt.Sprintf("Goal [%s]", teamName)
+	if goal.Player != "" {
+		header = fmt.Sprintf("%s %d' [%s]", goal.Player, goal.Minute, teamName)
+	}
+	if goal.Reason != "" {
+		header += " - " + goal.Reason
+	}
+
+	return fmt.Sprintf("%s\n%s %d - %d %s",
+		header,
+		homeTeam.ShortName,
+		homeScore,
+		awayScore,
+		awayTeam.ShortName,
+	)
+}
+
+// PenaltyKick sends a desktop notification for a penalty shootout kick,
+// with the taker and the running shootout score.
+func (n *DesktopNotifier) PenaltyKick(kick api.PenaltyKick, homeTeam, awayTeam api.Team) error {
+	if !n.enabled {
+		return nil
+	}
+
+	_, _ = os.Stderr.WriteString("\a")
+
+	title := constants.NotificationTitlePenaltyScored
+	if !kick.Scored {
+		title = constants.NotificationTitlePenaltyMissed
+	}
+	message := formatPenaltyKickMessage(kick, homeTeam, awayTeam)
+
+	_ = beeep.Notify(title, message, getIconPath())
+
+	return nil
+}
+
+// formatPenaltyKickMessage creates the notification message for a shootout kick.
+// Format: "Taker [Team]\nHome 3 - 2 Away (pens)"
+func formatPenaltyKickMessage(kick api.PenaltyKick, homeTeam, awayTeam api.Team) string {
+	team := awayTeam
+	if kick.IsHome {
+		team = homeTeam
+	}
+	teamName := team.ShortName
+	if teamName == "" {
+		teamName = team.Name
+	}
+
+	taker := kick.Player
+	if taker == "" {
+		taker = "Unknown"
+	}
+
+	return fmt.Sprintf("%s [%s]\n%s %d - %d %s (pens)",
+		taker,
+		teamName,
+		homeTeam.ShortName,
+		kick.HomeScore,
+		kick.AwayScore,
+		awayTeam.ShortName,
+	)
+}
+
 // formatGoalMessage creates the notification message for a goal.
 // Format: "Scorer (Team) 34' | Home 2-1 Away"
 func formatGoalMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeScore, awayScore int) string {
@@ -103,6 +201,11 @@ func formatGoalMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeSc
 	if event.Player != nil {
 		scorer = *event.Player
 	}
+	if event.IsOwnGoal {
+		scorer += " (OG)"
+	} else if event.IsPenalty {
+		scorer += " (pen)"
+	}
 
 	// Determine which team scored
 	teamName := event.Team.ShortName
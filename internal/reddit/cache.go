@@ -1,7 +1,7 @@
 package reddit
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -23,6 +23,29 @@ const (
 	NotFoundMarker = "__NOT_FOUND__"
 )
 
+// goalLinksSchema is the versioned format of the goal links cache.
+// Version 0 was a bare array of links; version 1 wraps it in an object.
+var goalLinksSchema = data.FileSchema{
+	Name:    "goal links cache",
+	Format:  data.FormatJSON,
+	Version: 1,
+	Migrations: []data.Migration{
+		func(doc any) (any, error) {
+			links, ok := doc.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of links")
+			}
+			return map[string]any{"links": links}, nil
+		},
+	},
+}
+
+// goalLinksFile is the on-disk format of the goal links cache.
+type goalLinksFile struct {
+	Version int        `json:"version"`
+	Links   []GoalLink `json:"links"`
+}
+
 // GoalLinkCache provides persistent storage for goal replay links.
 type GoalLinkCache struct {
 	mu       sync.RWMutex
@@ -42,8 +65,10 @@ func NewGoalLinkCache() (*GoalLinkCache, error) {
 		filePath: filepath.Join(dir, goalLinksFileName),
 	}
 
-	// Load existing cache from disk (silently ignore errors - start with empty cache)
-	_ = cache.load()
+	// Load existing cache from disk (start with empty cache on errors, moving corrupt files aside)
+	if err := cache.load(); err != nil {
+		goalLinksSchema.Recover(err)
+	}
 
 	// Clean expired entries on startup to keep file size manageable
 	_ = cache.CleanExpired()
@@ -169,21 +194,16 @@ func (c *GoalLinkCache) CleanExpired() error {
 
 // load reads the cache from disk.
 func (c *GoalLinkCache) load() error {
-	data, err := os.ReadFile(c.filePath)
-	if err != nil {
-		if os.IsNotExist(err) {
+	var file goalLinksFile
+	if err := goalLinksSchema.Load(c.filePath, &file); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
 			return nil // No cache file yet, that's fine
 		}
-		return fmt.Errorf("read cache file: %w", err)
-	}
-
-	var links []GoalLink
-	if err := json.Unmarshal(data, &links); err != nil {
-		return fmt.Errorf("parse cache file: %w", err)
+		return err
 	}
 
 	// Convert to map
-	for _, link := range links {
+	for _, link := range file.Links {
 		key := makeKey(GoalLinkKey{MatchID: link.MatchID, Minute: link.Minute})
 		c.links[key] = link
 	}
@@ -199,12 +219,8 @@ func (c *GoalLinkCache) saveLocked() error {
 		links = append(links, link)
 	}
 
-	data, err := json.MarshalIndent(links, "", "  ")
-	if err != nil {
-		return fmt.Errorf("marshal cache: %w", err)
-	}
-
-	if err := os.WriteFile(c.filePath, data, 0644); err != nil {
+	file := goalLinksFile{Version: goalLinksSchema.Version, Links: links}
+	if err := goalLinksSchema.Save(c.filePath, file); err != nil {
 		return fmt.Errorf("write cache file: %w", err)
 	}
 
@@ -0,0 +1,263 @@
+// Package theme defines golazo's colour palettes.
+// The ui packages read the active theme when building styles and gradients.
+package theme
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Built-in theme names.
+const (
+	Neon         = "neon"
+	HighContrast = "high-contrast"
+	Light        = "light"
+	Mono         = "mono"
+)
+
+// Default is the theme used unless another is configured.
+const Default = Neon
+
+// Theme is a colour palette. Colours are adaptive: the Light variant is used on
+// light terminal backgrounds and the Dark one on dark backgrounds.
+// Custom themes are decoded from the themes section of config.yaml.
+type Theme struct {
+	Name string `yaml:"name"`
+
+	Primary lipgloss.AdaptiveColor `yaml:"primary,omitempty"`  // Live status, selection, panel borders (red in neon)
+	Accent  lipgloss.AdaptiveColor `yaml:"accent,omitempty"`   // Headers, team names, highlights (cyan in neon)
+	Warning lipgloss.AdaptiveColor `yaml:"warning,omitempty"`  // Yellow cards
+	Text    lipgloss.AdaptiveColor `yaml:"text,omitempty"`     // Main text
+	TextAlt lipgloss.AdaptiveColor `yaml:"text_alt,omitempty"` // Values and secondary text
+	Black   lipgloss.AdaptiveColor `yaml:"black,omitempty"`    // Text on bright backgrounds
+
+	Surface    lipgloss.AdaptiveColor `yaml:"surface,omitempty"`     // Badge and bar backgrounds
+	SurfaceDim lipgloss.AdaptiveColor `yaml:"surface_dim,omitempty"` // Separators and underlines
+	Muted      lipgloss.AdaptiveColor `yaml:"muted,omitempty"`       // Unselected descriptions
+	Dim        lipgloss.AdaptiveColor `yaml:"dim,omitempty"`         // Dim text and labels
+	Subtle     lipgloss.AdaptiveColor `yaml:"subtle,omitempty"`      // Very dim text (filtered-out items)
+
+	// Gradients blend between two hex colours, so they are given per background.
+	GradientStart lipgloss.AdaptiveColor `yaml:"gradient_start,omitempty"` // Left/home end of gradients, hex
+	GradientEnd   lipgloss.AdaptiveColor `yaml:"gradient_end,omitempty"`   // Right/away end of gradients, hex
+	GradientEmpty lipgloss.AdaptiveColor `yaml:"gradient_empty,omitempty"` // Unfilled part of gradient bars, hex
+	HeaderDim     lipgloss.AdaptiveColor `yaml:"header_dim,omitempty"`     // Unfocused headers and their diagonal fill, hex
+
+	// NoColor disables colour output entirely, keeping bold and italics.
+	NoColor bool `yaml:"no_color,omitempty"`
+}
+
+// adaptive returns a colour that is the same on light and dark backgrounds.
+func adaptive(c string) lipgloss.AdaptiveColor {
+	return lipgloss.AdaptiveColor{Light: c, Dark: c}
+}
+
+// themes lists the built-in themes, in the order they are offered.
+var themes = []Theme{
+	{
+		Name:          Neon,
+		Primary:       lipgloss.AdaptiveColor{Light: "124", Dark: "196"}, // Dark red / Bright red
+		Accent:        lipgloss.AdaptiveColor{Light: "23", Dark: "51"},   // Darker cyan / Electric cyan
+		Warning:       lipgloss.AdaptiveColor{Light: "136", Dark: "226"}, // Dark gold / Bright yellow
+		Text:          lipgloss.AdaptiveColor{Light: "235", Dark: "255"},
+		TextAlt:       lipgloss.AdaptiveColor{Light: "236", Dark: "15"},
+		Black:         adaptive("0"),
+		Surface:       lipgloss.AdaptiveColor{Light: "252", Dark: "236"},
+		SurfaceDim:    lipgloss.AdaptiveColor{Light: "249", Dark: "239"},
+		Muted:         lipgloss.AdaptiveColor{Light: "245", Dark: "240"},
+		Dim:           lipgloss.AdaptiveColor{Light: "243", Dark: "244"},
+		Subtle:        lipgloss.AdaptiveColor{Light: "246", Dark: "238"},
+		GradientStart: lipgloss.AdaptiveColor{Light: "#006161", Dark: "#00FFFF"},
+		GradientEnd:   lipgloss.AdaptiveColor{Light: "#8B0000", Dark: "#FF0000"},
+		GradientEmpty: adaptive("#444444"),
+		HeaderDim:     lipgloss.AdaptiveColor{Light: "#666666", Dark: "#555555"},
+	},
+	{
+		// Pure colours and brighter secondary text for low-vision users and poor displays
+		Name:          HighContrast,
+		Primary:       lipgloss.AdaptiveColor{Light: "#A00000", Dark: "#FF4040"},
+		Accent:        lipgloss.AdaptiveColor{Light: "#00007F", Dark: "#00FFFF"},
+		Warning:       lipgloss.AdaptiveColor{Light: "#7F5F00", Dark: "#FFFF00"},
+		Text:          lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+		TextAlt:       lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+		Black:         adaptive("#000000"),
+		Surface:       lipgloss.AdaptiveColor{Light: "#C0C0C0", Dark: "#404040"},
+		SurfaceDim:    lipgloss.AdaptiveColor{Light: "#606060", Dark: "#A0A0A0"},
+		Muted:         lipgloss.AdaptiveColor{Light: "#202020", Dark: "#E0E0E0"},
+		Dim:           lipgloss.AdaptiveColor{Light: "#303030", Dark: "#D0D0D0"},
+		Subtle:        lipgloss.AdaptiveColor{Light: "#505050", Dark: "#B0B0B0"},
+		GradientStart: lipgloss.AdaptiveColor{Light: "#00007F", Dark: "#00FFFF"},
+		GradientEnd:   lipgloss.AdaptiveColor{Light: "#A00000", Dark: "#FF4040"},
+		GradientEmpty: lipgloss.AdaptiveColor{Light: "#A0A0A0", Dark: "#606060"},
+		HeaderDim:     lipgloss.AdaptiveColor{Light: "#404040", Dark: "#C0C0C0"},
+	},
+	{
+		// Dark text on light backgrounds, for terminals whose background isn't detected
+		Name:          Light,
+		Primary:       adaptive("#A00000"),
+		Accent:        adaptive("#005F87"),
+		Warning:       adaptive("#875F00"),
+		Text:          adaptive("#1C1C1C"),
+		TextAlt:       adaptive("#262626"),
+		Black:         adaptive("#000000"),
+		Surface:       adaptive("#E4E4E4"),
+		SurfaceDim:    adaptive("#BCBCBC"),
+		Muted:         adaptive("#8A8A8A"),
+		Dim:           adaptive("#6C6C6C"),
+		Subtle:        adaptive("#9E9E9E"),
+		GradientStart: adaptive("#005F87"),
+		GradientEnd:   adaptive("#A00000"),
+		GradientEmpty: adaptive("#D0D0D0"),
+		HeaderDim:     adaptive("#8A8A8A"),
+	},
+	{
+		// No colours at all, also used when NO_COLOR is set (https://no-color.org)
+		Name:          Mono,
+		GradientStart: adaptive("#FFFFFF"),
+		GradientEnd:   adaptive("#FFFFFF"),
+		GradientEmpty: adaptive("#FFFFFF"),
+		HeaderDim:     adaptive("#FFFFFF"),
+		NoColor:       true,
+	},
+}
+
+// custom lists the themes defined in config.yaml, offered after the built-in ones.
+var custom []Theme
+
+// SetCustom makes the themes defined in config.yaml available, replacing any set before.
+// Colours a theme leaves out are taken from the default theme; a colour given for only
+// one background is used for both. The themes must have passed Validate.
+func SetCustom(themes []Theme) {
+	base, _ := Get(Default)
+	custom = nil
+	for _, t := range themes {
+		for i, c := range t.colors() {
+			switch {
+			case c.Light == "" && c.Dark == "":
+				*c.AdaptiveColor = *base.colors()[i].AdaptiveColor
+			case c.Light == "":
+				c.Light = c.Dark
+			case c.Dark == "":
+				c.Dark = c.Light
+			}
+		}
+		custom = append(custom, t)
+	}
+}
+
+// Names returns the names of the built-in themes, followed by the custom ones.
+func Names() []string {
+	names := make([]string, 0, len(themes)+len(custom))
+	for _, t := range append(slices.Clone(themes), custom...) {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// Get returns the named theme.
+func Get(name string) (Theme, error) {
+	for _, t := range append(slices.Clone(themes), custom...) {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return Theme{}, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(Names(), ", "))
+}
+
+// IsBuiltin reports whether name is one of the built-in themes.
+func IsBuiltin(name string) bool {
+	return slices.ContainsFunc(themes, func(t Theme) bool { return t.Name == name })
+}
+
+// hexColor matches the hex colours gradients can blend.
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Validate checks a custom theme: its name, and that every colour given is an ANSI
+// colour number (0-255) or a hex colour. Gradient and header colours must be hex.
+func (t Theme) Validate() error {
+	name := strings.TrimSpace(t.Name)
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if name != t.Name {
+		return fmt.Errorf("name %q has surrounding spaces", t.Name)
+	}
+	if IsBuiltin(name) {
+		return fmt.Errorf("name %q is a built-in theme", name)
+	}
+
+	for _, c := range t.colors() {
+		for _, value := range []string{c.Light, c.Dark} {
+			if value == "" || hexColor.MatchString(value) {
+				continue
+			}
+			if c.hexOnly {
+				return fmt.Errorf("%s: invalid colour %q (must be hex, e.g. #00FFFF)", c.key, value)
+			}
+			if n, err := strconv.Atoi(value); err != nil || n < 0 || n > 255 {
+				return fmt.Errorf("%s: invalid colour %q (use an ANSI colour number 0-255 or hex, e.g. #00FFFF)", c.key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// themeColor is a colour of a theme, with its config.yaml key.
+type themeColor struct {
+	*lipgloss.AdaptiveColor
+	key     string
+	hexOnly bool // Blended in gradients
+}
+
+// colors returns the theme's colours, in field order.
+func (t *Theme) colors() []themeColor {
+	return []themeColor{
+		{&t.Primary, "primary", false},
+		{&t.Accent, "accent", false},
+		{&t.Warning, "warning", false},
+		{&t.Text, "text", false},
+		{&t.TextAlt, "text_alt", false},
+		{&t.Black, "black", false},
+		{&t.Surface, "surface", false},
+		{&t.SurfaceDim, "surface_dim", false},
+		{&t.Muted, "muted", false},
+		{&t.Dim, "dim", false},
+		{&t.Subtle, "subtle", false},
+		{&t.GradientStart, "gradient_start", true},
+		{&t.GradientEnd, "gradient_end", true},
+		{&t.GradientEmpty, "gradient_empty", true},
+		{&t.HeaderDim, "header_dim", true},
+	}
+}
+
+// NoColorRequested reports whether the NO_COLOR environment variable asks for no colours.
+func NoColorRequested() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// current is the active theme.
+var current = themes[0]
+
+// Current returns the active theme.
+func Current() Theme {
+	return current
+}
+
+// Set makes t the active theme. Styles built from the previous theme must be rebuilt.
+func Set(t Theme) {
+	current = t
+}
+
+// Hex resolves an adaptive hex colour for the terminal background.
+func Hex(c lipgloss.AdaptiveColor) string {
+	if lipgloss.HasDarkBackground() {
+		return c.Dark
+	}
+	return c.Light
+}
@@ -0,0 +1,71 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		theme   Theme
+		wantErr bool
+		desc    string
+	}{
+		{Theme{Name: "ocean"}, false, "name only"},
+		{Theme{Name: "ocean", Primary: lipgloss.AdaptiveColor{Light: "#004080", Dark: "39"}}, false, "hex and ANSI colours"},
+		{Theme{Name: "ocean", Accent: lipgloss.AdaptiveColor{Dark: "#3AF"}}, false, "short hex"},
+		{Theme{}, true, "missing name"},
+		{Theme{Name: " ocean"}, true, "name with spaces"},
+		{Theme{Name: Neon}, true, "built-in name"},
+		{Theme{Name: "ocean", Primary: adaptive("red")}, true, "colour name"},
+		{Theme{Name: "ocean", Primary: adaptive("256")}, true, "ANSI colour out of range"},
+		{Theme{Name: "ocean", GradientStart: adaptive("51")}, true, "ANSI gradient colour"},
+		{Theme{Name: "ocean", HeaderDim: lipgloss.AdaptiveColor{Light: "#12345"}}, true, "malformed hex"},
+	}
+
+	for _, tt := range tests {
+		if err := tt.theme.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate() error = %v; want error %v - %s", err, tt.wantErr, tt.desc)
+		}
+	}
+}
+
+func TestSetCustom(t *testing.T) {
+	defer SetCustom(nil)
+	SetCustom([]Theme{{
+		Name:    "ocean",
+		Primary: lipgloss.AdaptiveColor{Light: "#004080", Dark: "#3399FF"},
+		Accent:  lipgloss.AdaptiveColor{Dark: "45"},
+	}})
+
+	got, err := Get("ocean")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	neon, _ := Get(Neon)
+
+	tests := []struct {
+		got  lipgloss.AdaptiveColor
+		want lipgloss.AdaptiveColor
+		desc string
+	}{
+		{got.Primary, lipgloss.AdaptiveColor{Light: "#004080", Dark: "#3399FF"}, "both backgrounds given"},
+		{got.Accent, adaptive("45"), "one background used for both"},
+		{got.Warning, neon.Warning, "left out, taken from the default theme"},
+		{got.GradientEnd, neon.GradientEnd, "gradient left out"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("colour = %+v; want %+v - %s", tt.got, tt.want, tt.desc)
+		}
+	}
+
+	if names := Names(); names[len(names)-1] != "ocean" {
+		t.Errorf("Names() = %v; want the custom theme last", names)
+	}
+	SetCustom(nil)
+	if _, err := Get("ocean"); err == nil {
+		t.Errorf("Get() after clearing = nil error; want unknown theme")
+	}
+}
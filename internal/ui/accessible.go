@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// accessible replaces colour-only cues with text labels and turns off animations,
+// for screen reader and colour-blind users.
+var accessible bool
+
+// SetAccessible turns accessible mode on or off. Call at startup, before the app runs.
+func SetAccessible(on bool) {
+	accessible = on
+}
+
+// Accessible reports whether accessible mode is on.
+func Accessible() bool {
+	return accessible
+}
+
+// cardLabel names a booking, for accessible mode.
+func cardLabel(card api.CardColor) string {
+	switch card {
+	case api.CardYellow:
+		return "Yellow card"
+	case api.CardSecondYellow:
+		return "Second yellow"
+	default:
+		return "Red card"
+	}
+}
+
+// cardMark returns a player's booking marker: coloured squares, or YC/2YC/RC in accessible mode.
+func cardMark(card api.CardColor) string {
+	if accessible {
+		switch card {
+		case api.CardYellow:
+			return neonYellowCardStyle.Render("YC")
+		case api.CardSecondYellow:
+			return neonRedCardStyle.Render("2YC")
+		default:
+			return neonRedCardStyle.Render("RC")
+		}
+	}
+
+	switch card {
+	case api.CardYellow:
+		return neonYellowCardStyle.Render(CardSymbolYellow)
+	case api.CardSecondYellow:
+		return neonYellowCardStyle.Render(CardSymbolYellow) + neonRedCardStyle.Render(CardSymbolRed)
+	default:
+		return neonRedCardStyle.Render(CardSymbolRed)
+	}
+}
+
+// EventAnnouncement describes a match event as a single plain-text sentence for
+// the announcement log, e.g. "Arsenal 2-1 Chelsea, 67': Goal for Arsenal, Bukayo Saka (penalty)".
+func EventAnnouncement(event api.MatchEvent, details *api.MatchDetails) string {
+	player := eventPlayerName(event)
+	team := event.Team.Name
+	if team == "" {
+		team = "unknown team"
+	}
+
+	var text string
+	switch event.Kind {
+	case api.EventKindGoal:
+		switch {
+		case event.IsOwnGoal:
+			// The event's team is the one credited with the goal
+			text = fmt.Sprintf("Own goal by %s, goal for %s", player, team)
+		case event.IsPenalty:
+			text = fmt.Sprintf("Goal for %s, %s (penalty)", team, player)
+		default:
+			text = fmt.Sprintf("Goal for %s, %s", team, player)
+		}
+		if event.Assist != nil && *event.Assist != "" {
+			text += ", assisted by " + *event.Assist
+		}
+	case api.EventKindDisallowedGoal:
+		text = fmt.Sprintf("Goal disallowed for %s, %s", team, player)
+		if event.Decision != nil && *event.Decision != "" {
+			text += " (" + *event.Decision + ")"
+		}
+	case api.EventKindMissedPenalty:
+		text = fmt.Sprintf("Penalty missed by %s, %s", player, team)
+	case api.EventKindVAR:
+		text = "VAR review"
+		if event.Decision != nil && *event.Decision != "" {
+			text += ": " + *event.Decision
+		}
+	case api.EventKindCard:
+		text = fmt.Sprintf("%s for %s, %s", cardLabel(event.Card), player, team)
+	case api.EventKindSubstitution:
+		text = "Substitution for " + team
+		if event.PlayerIn != nil && event.PlayerIn.Name != "" {
+			text += ", on " + event.PlayerIn.Name
+		}
+		if event.PlayerOut != nil && event.PlayerOut.Name != "" {
+			text += ", off " + event.PlayerOut.Name
+		}
+	default:
+		text = event.Detail
+		if event.Player != nil && *event.Player != "" {
+			text = *event.Player
+		}
+	}
+
+	if details == nil {
+		return fmt.Sprintf("%s: %s", eventMinute(event), text)
+	}
+	home, away := 0, 0
+	if details.HomeScore != nil {
+		home = *details.HomeScore
+	}
+	if details.AwayScore != nil {
+		away = *details.AwayScore
+	}
+	return fmt.Sprintf("%s %d-%d %s, %s: %s",
+		details.HomeTeam.Name, home, away, details.AwayTeam.Name, eventMinute(event), text)
+}
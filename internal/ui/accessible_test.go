@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestEventAnnouncement(t *testing.T) {
+	name := func(s string) *string { return &s }
+	arsenal := api.Team{ID: 10, Name: "Arsenal"}
+	chelsea := api.Team{ID: 20, Name: "Chelsea"}
+	home, away := 2, 1
+	details := &api.MatchDetails{Match: api.Match{HomeTeam: arsenal, AwayTeam: chelsea, HomeScore: &home, AwayScore: &away}}
+
+	tests := []struct {
+		event   api.MatchEvent
+		details *api.MatchDetails
+		want    string
+		desc    string
+	}{
+		{
+			event:   api.MatchEvent{Minute: 67, Kind: api.EventKindGoal, Team: arsenal, Player: name("Bukayo Saka"), IsPenalty: true},
+			details: details,
+			want:    "Arsenal 2-1 Chelsea, 67': Goal for Arsenal, Bukayo Saka (penalty)",
+			desc:    "penalty goal with the score",
+		},
+		{
+			event: api.MatchEvent{Minute: 12, Kind: api.EventKindGoal, Team: arsenal, Player: name("Kai Havertz"), Assist: name("Declan Rice")},
+			want:  "12': Goal for Arsenal, Kai Havertz, assisted by Declan Rice",
+			desc:  "assisted goal without details",
+		},
+		{
+			event: api.MatchEvent{Minute: 41, Kind: api.EventKindGoal, Team: arsenal, Player: name("Levi Colwill"), IsOwnGoal: true},
+			want:  "41': Own goal by Levi Colwill, goal for Arsenal",
+			desc:  "own goal names the team credited",
+		},
+		{
+			event: api.MatchEvent{Minute: 50, Kind: api.EventKindDisallowedGoal, Team: chelsea, Player: name("Cole Palmer"), Decision: name("Offside")},
+			want:  "50': Goal disallowed for Chelsea, Cole Palmer (Offside)",
+			desc:  "disallowed goal with the decision",
+		},
+		{
+			event: api.MatchEvent{Minute: 58, Kind: api.EventKindMissedPenalty, Team: chelsea, Player: name("Cole Palmer")},
+			want:  "58': Penalty missed by Cole Palmer, Chelsea",
+			desc:  "missed penalty",
+		},
+		{
+			event: api.MatchEvent{Minute: 60, Kind: api.EventKindVAR, Decision: name("Penalty cancelled")},
+			want:  "60': VAR review: Penalty cancelled",
+			desc:  "VAR review",
+		},
+		{
+			event: api.MatchEvent{Minute: 75, DisplayMinute: "75'", Kind: api.EventKindCard, Player: name("Moisés Caicedo"), Card: api.CardSecondYellow},
+			want:  "75': Second yellow for Moisés Caicedo, unknown team",
+			desc:  "card without a team",
+		},
+		{
+			event: api.MatchEvent{Minute: 80, DisplayMinute: "90+2'", Kind: api.EventKindSubstitution, Team: arsenal, PlayerIn: &api.EventPlayer{Name: "Gabriel Martinelli"}, PlayerOut: &api.EventPlayer{Name: "Bukayo Saka"}},
+			want:  "90+2': Substitution for Arsenal, on Gabriel Martinelli, off Bukayo Saka",
+			desc:  "substitution",
+		},
+		{
+			event: api.MatchEvent{Minute: 45, Kind: api.EventKindAddedTime, Detail: "+3"},
+			want:  "45': +3",
+			desc:  "other event falls back to its detail",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := EventAnnouncement(tt.event, tt.details); got != tt.want {
+			t.Errorf("EventAnnouncement() = %q; want %q - %s", got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestCardMark(t *testing.T) {
+	defer SetAccessible(false)
+
+	tests := []struct {
+		card       api.CardColor
+		accessible bool
+		want       string
+	}{
+		{api.CardYellow, false, CardSymbolYellow},
+		{api.CardSecondYellow, false, CardSymbolYellow + CardSymbolRed},
+		{api.CardRed, false, CardSymbolRed},
+		{api.CardYellow, true, "YC"},
+		{api.CardSecondYellow, true, "2YC"},
+		{api.CardRed, true, "RC"},
+	}
+
+	for _, tt := range tests {
+		SetAccessible(tt.accessible)
+		if got := cardMark(tt.card); got != tt.want {
+			t.Errorf("cardMark(%q) = %q; want %q - accessible %v", tt.card, got, tt.want, tt.accessible)
+		}
+	}
+}
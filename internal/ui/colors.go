@@ -1,13 +1,31 @@
 package ui
 
+import "github.com/charmbracelet/lipgloss"
+
 // Consolidated color palette for all views - Red & Cyan theme
 // These aliases reference the main color definitions in neon_styles.go
 var (
 	// Primary colors
-	textColor      = neonWhiteAlt // Standard white
-	accentColor    = neonCyan     // Bright cyan
-	secondaryColor = neonRed      // Bright red
-	dimColor       = neonDim      // Gray
-	highlightColor = neonCyan     // Cyan highlight (same as accent)
-	borderColor    = neonCyan     // Cyan borders (same as accent)
+	textColor      lipgloss.AdaptiveColor // Standard white
+	accentColor    lipgloss.AdaptiveColor // Bright cyan
+	secondaryColor lipgloss.AdaptiveColor // Bright red
+	dimColor       lipgloss.AdaptiveColor // Gray
+	highlightColor lipgloss.AdaptiveColor // Cyan highlight (same as accent)
+	borderColor    lipgloss.AdaptiveColor // Cyan borders (same as accent)
 )
+
+// applyColorAliases points the aliases at the current neon palette.
+func applyColorAliases() {
+	textColor = neonWhiteAlt
+	accentColor = neonCyan
+	secondaryColor = neonRed
+	dimColor = neonDim
+	highlightColor = neonCyan
+	borderColor = neonCyan
+
+	delegateNeonRed = neonRed
+	delegateNeonCyan = neonCyan
+	delegateNeonWhite = neonWhite
+	delegateNeonGray = neonDim
+	delegateNeonDim = neonDimGray
+}
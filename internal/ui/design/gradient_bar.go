@@ -4,6 +4,7 @@ package design
 import (
 	"strings"
 
+	"github.com/0xjuanma/golazo/internal/theme"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lucasb-eyer/go-colorful"
 )
@@ -33,16 +34,18 @@ func DefaultGradientBarConfig(width int, homeVal, awayVal float64) GradientBarCo
 	}
 }
 
-// AdaptiveGradientColors returns the appropriate gradient start/end hex colors
-// based on the terminal background (light or dark).
-// Dark terminals get bright vibrant colors, light terminals get darker saturated colors.
+// AdaptiveGradientColors returns the active theme's gradient start/end hex colors
+// for the terminal background (light or dark).
+// In the default theme, dark terminals get bright cyan to bright red and light
+// terminals darker, saturated variants for better visibility.
 func AdaptiveGradientColors() (startHex, endHex string) {
-	if lipgloss.HasDarkBackground() {
-		// Dark terminal: bright cyan to bright red
-		return "#00FFFF", "#FF0000"
-	}
-	// Light terminal: darker cyan (30% darker) to darker red for better visibility
-	return "#006161", "#8B0000"
+	t := theme.Current()
+	return theme.Hex(t.GradientStart), theme.Hex(t.GradientEnd)
+}
+
+// emptyBarColor returns the color of the unfilled part of gradient bars.
+func emptyBarColor() lipgloss.Color {
+	return lipgloss.Color(theme.Hex(theme.Current().GradientEmpty))
 }
 
 // RenderGradientBar creates a comparison bar with gradient coloring.
@@ -106,7 +109,7 @@ func RenderGradientBar(cfg GradientBarConfig) string {
 			homeBar.WriteString(charStyle.Render(cfg.FilledChar))
 		} else {
 			// Empty portion - dim
-			homeBar.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#444444")).Render(cfg.EmptyChar))
+			homeBar.WriteString(lipgloss.NewStyle().Foreground(emptyBarColor()).Render(cfg.EmptyChar))
 		}
 	}
 
@@ -122,11 +125,11 @@ func RenderGradientBar(cfg GradientBarConfig) string {
 			awayBar.WriteString(charStyle.Render(cfg.FilledChar))
 		} else {
 			// Empty portion - dim
-			awayBar.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#444444")).Render(cfg.EmptyChar))
+			awayBar.WriteString(lipgloss.NewStyle().Foreground(emptyBarColor()).Render(cfg.EmptyChar))
 		}
 	}
 
-	separator := lipgloss.NewStyle().Foreground(lipgloss.Color("#666666")).Render("│")
+	separator := lipgloss.NewStyle().Foreground(theme.Current().HeaderDim).Render("│")
 	return homeBar.String() + separator + awayBar.String()
 }
 
@@ -152,7 +155,7 @@ func RenderSimpleGradientBar(value float64, width int) string {
 		if i < filledWidth {
 			result.WriteString(charStyle.Render("█"))
 		} else {
-			result.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#444444")).Render("░"))
+			result.WriteString(lipgloss.NewStyle().Foreground(emptyBarColor()).Render("░"))
 		}
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/0xjuanma/golazo/internal/theme"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lucasb-eyer/go-colorful"
 )
@@ -38,9 +39,9 @@ func renderHeaderWithFocus(text string, width int, focused bool) string {
 		diagColor = startHex
 	} else {
 		// Dim style for unfocused
-		dimStyle := lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#666666", Dark: "#555555"}).Bold(true)
+		dimStyle := lipgloss.NewStyle().Foreground(theme.Current().HeaderDim).Bold(true)
 		title = dimStyle.Render(text)
-		diagColor = "#555555"
+		diagColor = theme.Hex(theme.Current().HeaderDim)
 	}
 
 	remainingWidth := width - lipgloss.Width(text) - 2
@@ -18,6 +18,37 @@ type DialogAction any
 // DialogActionClose signals that the dialog should be closed.
 type DialogActionClose struct{}
 
+// DialogActionOpen signals that a new dialog should be opened on top of the current one.
+type DialogActionOpen struct {
+	Dialog Dialog
+}
+
+// DialogActionTeamDetails requests a team's overview to be fetched and shown.
+type DialogActionTeamDetails struct {
+	TeamID int
+	Name   string
+}
+
+// DialogActionPlayerProfile requests a player's profile to be fetched and shown.
+// When PlayerID is 0 the player is looked up by Name.
+type DialogActionPlayerProfile struct {
+	PlayerID int
+	Name     string
+}
+
+// DialogActionToggleFavorite requests a team to be added to or removed from
+// the active profile's favourites.
+type DialogActionToggleFavorite struct {
+	TeamID int
+	Name   string
+}
+
+// DialogActionCommand requests a command palette entry to be run.
+// The palette is closed first.
+type DialogActionCommand struct {
+	Command PaletteCommand
+}
+
 // Dialog is a component that can be displayed as an overlay on top of the UI.
 type Dialog interface {
 	// ID returns the unique identifier of the dialog.
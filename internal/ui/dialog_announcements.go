@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const announcementsDialogID = "announcements"
+
+// AnnouncementsDialog lists the match events announced this session as plain
+// sentences, oldest first, so they read in order.
+type AnnouncementsDialog struct {
+	lines       []string
+	logPath     string
+	scrollIndex int
+	maxVisible  int
+}
+
+// NewAnnouncementsDialog creates a new announcements dialog scrolled to the newest lines.
+// logPath is the announcement log file, shown so it can be followed outside the app.
+func NewAnnouncementsDialog(lines []string, logPath string) *AnnouncementsDialog {
+	d := &AnnouncementsDialog{
+		lines:      lines,
+		logPath:    logPath,
+		maxVisible: 20, // Lines visible at once
+	}
+	d.scrollIndex = d.maxScroll()
+	return d
+}
+
+// ID returns the dialog identifier.
+func (d *AnnouncementsDialog) ID() string {
+	return announcementsDialogID
+}
+
+// maxScroll returns the scroll index showing the last lines.
+func (d *AnnouncementsDialog) maxScroll() int {
+	return max(len(d.lines)-d.maxVisible, 0)
+}
+
+// Update handles input for the announcements dialog.
+func (d *AnnouncementsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Announcements):
+			return d, DialogActionClose{}
+		case key.Matches(msg, km.Down):
+			if d.scrollIndex < d.maxScroll() {
+				d.scrollIndex++
+			}
+		case key.Matches(msg, km.Up):
+			if d.scrollIndex > 0 {
+				d.scrollIndex--
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the announcements.
+func (d *AnnouncementsDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 90, 30)
+
+	content := d.renderContent(dialogWidth - 6)
+	return RenderDialogFrameWithHelp(constants.PanelAnnouncements, content, scrollDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderContent renders one unstyled line per announcement, so screen readers
+// read the text without colour codes getting in the way.
+func (d *AnnouncementsDialog) renderContent(width int) string {
+	var lines []string
+	if len(d.lines) == 0 {
+		lines = append(lines, constants.EmptyNoAnnouncements)
+	}
+
+	end := min(d.scrollIndex+d.maxVisible, len(d.lines))
+	for _, line := range d.lines[d.scrollIndex:end] {
+		lines = append(lines, lipgloss.NewStyle().Width(width).Render(line))
+	}
+
+	if len(d.lines) > d.maxVisible {
+		lines = append(lines, "", fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, end, len(d.lines)))
+	}
+	if d.logPath != "" {
+		lines = append(lines, "", "Also written to "+d.logPath)
+	}
+	return strings.Join(lines, "\n")
+}
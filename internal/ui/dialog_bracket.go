@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const bracketDialogID = "bracket"
+
+const (
+	bracketCellWidth  = 20 // Width of a tie: name, aggregate, penalties and a gap
+	bracketLinkWidth  = 3  // Width of the connectors between rounds
+	bracketNameWidth  = 12 // Team name width within a tie
+	bracketTiePitch   = 3  // Rows per tie in the first visible round (two teams plus a gap)
+	bracketMaxVisible = 24 // Bracket rows visible at once
+)
+
+// BracketDialog displays the knockout rounds of a cup competition as a tree.
+// Ties involving the current match's teams are highlighted to show their path.
+type BracketDialog struct {
+	leagueName  string
+	bracket     *api.KnockoutBracket
+	homeTeamID  int
+	awayTeamID  int
+	firstRound  int // First round shown in the leftmost column
+	scrollIndex int
+}
+
+// NewBracketDialog creates a new bracket dialog.
+// bracket may be nil if the competition has no knockout rounds.
+// The tree starts at the round of the current match when it is found.
+func NewBracketDialog(leagueName string, bracket *api.KnockoutBracket, homeTeamID, awayTeamID int) *BracketDialog {
+	d := &BracketDialog{
+		leagueName: leagueName,
+		bracket:    bracket,
+		homeTeamID: homeTeamID,
+		awayTeamID: awayTeamID,
+	}
+	if bracket == nil {
+		return d
+	}
+	for i, round := range bracket.Rounds {
+		for _, tie := range round.Ties {
+			if d.isCurrentTie(tie) {
+				d.firstRound = i
+			}
+		}
+	}
+	return d
+}
+
+// ID returns the dialog identifier.
+func (d *BracketDialog) ID() string {
+	return bracketDialogID
+}
+
+// Update handles input for the bracket dialog.
+func (d *BracketDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Bracket):
+			return d, DialogActionClose{}
+		case key.Matches(msg, km.Left):
+			if d.firstRound > 0 {
+				d.firstRound--
+				d.scrollIndex = 0
+			}
+		case key.Matches(msg, km.Right):
+			if d.bracket != nil && d.firstRound < len(d.bracket.Rounds)-1 {
+				d.firstRound++
+				d.scrollIndex = 0
+			}
+		case key.Matches(msg, km.Down):
+			if d.scrollIndex < d.treeHeight()-bracketMaxVisible {
+				d.scrollIndex++
+			}
+		case key.Matches(msg, km.Up):
+			if d.scrollIndex > 0 {
+				d.scrollIndex--
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the bracket.
+func (d *BracketDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+
+	var content string
+	if d.bracket == nil || len(d.bracket.Rounds) == 0 {
+		content = dialogDimStyle.Render(fmt.Sprintf("No knockout bracket available for %s", d.leagueName))
+	} else {
+		content = d.renderContent(dialogWidth - 6)
+	}
+	return RenderDialogFrameWithHelp(d.leagueName+" Knockout", content, bracketDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// isCurrentTie reports whether a tie is between the current match's teams.
+func (d *BracketDialog) isCurrentTie(tie api.KnockoutTie) bool {
+	return (tie.HomeTeam.ID == d.homeTeamID && tie.AwayTeam.ID == d.awayTeamID) ||
+		(tie.HomeTeam.ID == d.awayTeamID && tie.AwayTeam.ID == d.homeTeamID)
+}
+
+// onPath reports whether a tie involves one of the current match's teams.
+func (d *BracketDialog) onPath(tie api.KnockoutTie) bool {
+	ids := []int{tie.HomeTeam.ID, tie.AwayTeam.ID}
+	for _, id := range ids {
+		if id != 0 && (id == d.homeTeamID || id == d.awayTeamID) {
+			return true
+		}
+	}
+	return false
+}
+
+// visibleRounds returns how many rounds fit side by side in width.
+func (d *BracketDialog) visibleRounds(width int) int {
+	fit := max((width+bracketLinkWidth)/(bracketCellWidth+bracketLinkWidth), 1)
+	return min(fit, len(d.bracket.Rounds)-d.firstRound)
+}
+
+// treeHeight returns the number of rows of the tree from the first visible round.
+func (d *BracketDialog) treeHeight() int {
+	if d.bracket == nil || d.firstRound >= len(d.bracket.Rounds) {
+		return 0
+	}
+	return len(d.bracket.Rounds[d.firstRound].Ties)*bracketTiePitch - 1
+}
+
+// tieRows returns the top row of every tie in each visible round.
+// Ties 2i and 2i+1 feed tie i of the next round, which sits between them.
+// Rounds that do not halve the number of ties are laid out row for row.
+func (d *BracketDialog) tieRows(rounds []api.KnockoutRound) [][]int {
+	rows := make([][]int, len(rounds))
+	for i := range rounds[0].Ties {
+		rows[0] = append(rows[0], i*bracketTiePitch)
+	}
+	for r := 1; r < len(rounds); r++ {
+		prev := rows[r-1]
+		for i := range rounds[r].Ties {
+			switch {
+			case len(prev) == 2*len(rounds[r].Ties):
+				rows[r] = append(rows[r], (prev[2*i]+prev[2*i+1]+1)/2)
+			case i < len(prev):
+				rows[r] = append(rows[r], prev[i])
+			default:
+				rows[r] = append(rows[r], i*bracketTiePitch)
+			}
+		}
+	}
+	return rows
+}
+
+// renderContent renders round headers, the tree and the current tie's legs.
+func (d *BracketDialog) renderContent(width int) string {
+	rounds := d.bracket.Rounds[d.firstRound : d.firstRound+d.visibleRounds(width)]
+	rows := d.tieRows(rounds)
+	height := d.treeHeight()
+
+	// Round headers
+	var headers []string
+	for r, round := range rounds {
+		if r > 0 {
+			headers = append(headers, strings.Repeat(" ", bracketLinkWidth))
+		}
+		headers = append(headers, dialogHeaderStyle.Width(bracketCellWidth).Render(truncateName(round.Name, bracketCellWidth)))
+	}
+	lines := []string{strings.Join(headers, ""), dialogSeparatorStyle.Render(strings.Repeat("─", width))}
+
+	// A round can be listed before its ties are drawn
+	if height <= 0 {
+		lines = append(lines, dialogDimStyle.Render(constants.EmptyBracketNotDrawn))
+		if more := d.renderPosition(len(rounds), 0, 0); more != "" {
+			lines = append(lines, more)
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	// Each row is built column by column so styled cells keep their width
+	grid := make([][]string, height)
+	for y := range grid {
+		grid[y] = make([]string, 2*len(rounds)-1)
+		for c := range grid[y] {
+			w := bracketCellWidth
+			if c%2 == 1 {
+				w = bracketLinkWidth
+			}
+			grid[y][c] = strings.Repeat(" ", w)
+		}
+	}
+
+	for r, round := range rounds {
+		for i, tie := range round.Ties {
+			top := rows[r][i]
+			if top+1 >= height {
+				continue
+			}
+			grid[top][2*r] = d.renderTeamLine(tie, true)
+			grid[top+1][2*r] = d.renderTeamLine(tie, false)
+		}
+		if r > 0 {
+			d.drawLinks(grid, 2*r-1, rounds[r-1], rows[r-1], round, rows[r])
+		}
+	}
+
+	endIdx := min(d.scrollIndex+bracketMaxVisible, height)
+	for _, row := range grid[d.scrollIndex:endIdx] {
+		lines = append(lines, strings.Join(row, ""))
+	}
+
+	if more := d.renderPosition(len(rounds), height, endIdx); more != "" {
+		lines = append(lines, more)
+	}
+
+	if legs := d.renderCurrentTie(); legs != "" {
+		lines = append(lines, "", lipgloss.NewStyle().Width(width).Render(legs))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderPosition renders indicators for the rounds and rows out of view, given the
+// number of rounds shown, the tree height and the end of the visible rows.
+func (d *BracketDialog) renderPosition(shown, height, endIdx int) string {
+	var more []string
+	if d.firstRound > 0 {
+		more = append(more, fmt.Sprintf("◂ %d earlier", d.firstRound))
+	}
+	if later := len(d.bracket.Rounds) - d.firstRound - shown; later > 0 {
+		more = append(more, fmt.Sprintf("%d later ▸", later))
+	}
+	if height > bracketMaxVisible {
+		more = append(more, fmt.Sprintf("rows %d-%d of %d", d.scrollIndex+1, endIdx, height))
+	}
+	if len(more) == 0 {
+		return ""
+	}
+	return dialogDimStyle.Render(strings.Join(more, "   "))
+}
+
+// renderTeamLine renders one side of a tie: name, aggregate score and penalties.
+// The winner is emphasised and the current match's teams are highlighted.
+func (d *BracketDialog) renderTeamLine(tie api.KnockoutTie, home bool) string {
+	t, agg, pens := tie.HomeTeam, tie.HomeAggregate, tie.HomePenalties
+	if !home {
+		t, agg, pens = tie.AwayTeam, tie.AwayAggregate, tie.AwayPenalties
+	}
+
+	name := t.ShortName
+	if name == "" {
+		name = t.Name
+	}
+	if name == "" {
+		name = "TBD"
+	}
+
+	score := " -"
+	if agg != nil {
+		score = fmt.Sprintf("%2d", *agg)
+	}
+	pen := "    "
+	if pens != nil {
+		pen = fmt.Sprintf(" (%d)", *pens)
+	}
+
+	style := dialogValueStyle
+	switch {
+	case t.ID != 0 && (t.ID == d.homeTeamID || t.ID == d.awayTeamID):
+		style = dialogHighlightStyle
+	case tie.WinnerID != 0 && tie.WinnerID != t.ID:
+		style = dialogDimStyle
+	case tie.WinnerID != 0:
+		style = dialogTeamStyle
+	}
+
+	line := fmt.Sprintf("%-*s %s%s", bracketNameWidth, truncateName(name, bracketNameWidth), score, pen)
+	return style.Width(bracketCellWidth).Render(line)
+}
+
+// drawLinks draws the connectors in column c from ties of one round to the next.
+// The connectors of ties on the current match's path are highlighted.
+func (d *BracketDialog) drawLinks(grid [][]string, c int, from api.KnockoutRound, fromRows []int, to api.KnockoutRound, toRows []int) {
+	set := func(y int, s string, highlight bool) {
+		if y < 0 || y >= len(grid) {
+			return
+		}
+		style := dialogSeparatorStyle
+		if highlight {
+			style = dialogHighlightStyle
+		}
+		grid[y][c] = style.Render(s)
+	}
+
+	if len(fromRows) != 2*len(toRows) {
+		// Not a halving round: link each tie straight across
+		for i := range toRows {
+			if i < len(from.Ties) {
+				set(toRows[i], "───", d.onPath(from.Ties[i]))
+			}
+		}
+		return
+	}
+
+	for i := range toRows {
+		upper, lower := from.Ties[2*i], from.Ties[2*i+1]
+		upperExit := fromRows[2*i] + 1 // Bottom line of the upper tie
+		lowerExit := fromRows[2*i+1]   // Top line of the lower tie
+		set(upperExit, "─┐ ", d.onPath(upper))
+		for y := upperExit + 1; y < lowerExit; y++ {
+			set(y, " │ ", (y < toRows[i] && d.onPath(upper)) || (y > toRows[i] && d.onPath(lower)))
+		}
+		set(lowerExit, "─┘ ", d.onPath(lower))
+		set(toRows[i], " ├─", d.onPath(to.Ties[i]))
+	}
+}
+
+// renderCurrentTie renders the legs of the tie between the current match's teams.
+func (d *BracketDialog) renderCurrentTie() string {
+	for _, round := range d.bracket.Rounds {
+		for _, tie := range round.Ties {
+			if !d.isCurrentTie(tie) {
+				continue
+			}
+
+			parts := []string{dialogHeaderStyle.Render(round.Name)}
+			for i, leg := range tie.Legs {
+				score := "vs"
+				if leg.HomeScore != nil && leg.AwayScore != nil {
+					score = fmt.Sprintf("%d-%d", *leg.HomeScore, *leg.AwayScore)
+				}
+				label := "Match"
+				if len(tie.Legs) > 1 {
+					label = fmt.Sprintf("Leg %d", i+1)
+				}
+				parts = append(parts, dialogDimStyle.Render(label+": ")+
+					dialogValueStyle.Render(fmt.Sprintf("%s %s %s", leg.HomeTeam.Name, score, leg.AwayTeam.Name)))
+			}
+			if tie.HomeAggregate != nil && len(tie.Legs) > 1 {
+				parts = append(parts, dialogDimStyle.Render("Agg: ")+
+					dialogValueStyle.Render(fmt.Sprintf("%d-%d", *tie.HomeAggregate, *tie.AwayAggregate)))
+			}
+			if tie.HomePenalties != nil {
+				parts = append(parts, dialogDimStyle.Render("Pens: ")+
+					dialogValueStyle.Render(fmt.Sprintf("%d-%d", *tie.HomePenalties, *tie.AwayPenalties)))
+			}
+			return strings.Join(parts, dialogDimStyle.Render("  │  "))
+		}
+	}
+	return ""
+}
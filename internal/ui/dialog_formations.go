@@ -2,16 +2,21 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
-	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 const formationsDialogID = "formations"
 
+// minPitchCellWidth is the narrowest player cell with which both line-ups share the pitch.
+const minPitchCellWidth = 9
+
 // FormationsDialog displays the match formations for both teams.
 type FormationsDialog struct {
 	homeTeam      string
@@ -20,7 +25,10 @@ type FormationsDialog struct {
 	awayFormation string
 	homeStarting  []api.PlayerInfo
 	awayStarting  []api.PlayerInfo
-	focusedTeam   int // 0 = home, 1 = away
+	events        []api.MatchEvent // Used for substitution and card markers
+	focusedTeam   int              // 0 = home, 1 = away
+	selected      int              // Index of the selected player in the focused team
+	pitchView     bool             // Show the line-ups laid out on a pitch
 }
 
 // NewFormationsDialog creates a new formations dialog.
@@ -28,6 +36,7 @@ func NewFormationsDialog(
 	homeTeam, awayTeam string,
 	homeFormation, awayFormation string,
 	homeStarting, awayStarting []api.PlayerInfo,
+	events []api.MatchEvent,
 ) *FormationsDialog {
 	return &FormationsDialog{
 		homeTeam:      homeTeam,
@@ -36,6 +45,7 @@ func NewFormationsDialog(
 		awayFormation: awayFormation,
 		homeStarting:  homeStarting,
 		awayStarting:  awayStarting,
+		events:        events,
 		focusedTeam:   0,
 	}
 }
@@ -49,25 +59,64 @@ func (d *FormationsDialog) ID() string {
 func (d *FormationsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc", "f", "q":
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Formations):
 			return d, DialogActionClose{}
-		case "tab", "h", "l", "left", "right":
+		case key.Matches(msg, dialogTabKey, km.Left, km.Right):
 			// Toggle between home and away
 			d.focusedTeam = 1 - d.focusedTeam
+			d.selected = 0
+		case key.Matches(msg, km.Up):
+			if d.selected > 0 {
+				d.selected--
+			}
+		case key.Matches(msg, km.Down):
+			if d.selected < len(d.focusedPlayers())-1 {
+				d.selected++
+			}
+		case key.Matches(msg, km.Select):
+			players := d.focusedPlayers()
+			if d.selected < len(players) {
+				teamName := d.homeTeam
+				if d.focusedTeam == 1 {
+					teamName = d.awayTeam
+				}
+				return d, DialogActionOpen{Dialog: NewPlayerDialog(players[d.selected], teamName, d.events)}
+			}
+		case key.Matches(msg, pitchViewKey):
+			// Toggle between list and pitch view
+			d.pitchView = !d.pitchView
 		}
 	}
 	return d, nil
 }
 
+// focusedPlayers returns the starting lineup of the focused team.
+func (d *FormationsDialog) focusedPlayers() []api.PlayerInfo {
+	if d.focusedTeam == 1 {
+		return d.awayStarting
+	}
+	return d.homeStarting
+}
+
 // View renders the formations view.
 func (d *FormationsDialog) View(width, height int) string {
-	// Larger dimensions for better readability
-	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+	// Larger dimensions for better readability; the pitch uses the full width for both teams
+	contentWidth := 97
+	if d.pitchView {
+		contentWidth = DefaultDialogMaxWidth
+	}
+	dialogWidth, dialogHeight := DialogSize(width, height, contentWidth, 36)
 
 	// Build the content
-	content := d.renderFormations(dialogWidth - 6)
-	return RenderDialogFrameWithHelp("Formations", content, constants.HelpFormationsDialog, dialogWidth, dialogHeight)
+	var content string
+	if d.pitchView {
+		content = d.renderPitchView(dialogWidth - 6)
+	} else {
+		content = d.renderFormations(dialogWidth - 6)
+	}
+	return RenderDialogFrameWithHelp("Formations", content, formationsDialogHelp(), dialogWidth, dialogHeight)
 }
 
 // renderFormations renders both team formations side by side.
@@ -121,8 +170,8 @@ func (d *FormationsDialog) renderTeamPanel(teamName, formation string, players [
 		noData := dialogDimStyle.Width(width).Align(lipgloss.Center).Render("Lineup not available")
 		lines = append(lines, noData)
 	} else {
-		for _, player := range players {
-			playerLine := d.renderPlayerLine(player, width, focused)
+		for i, player := range players {
+			playerLine := d.renderPlayerLine(player, width, focused, focused && i == d.selected)
 			lines = append(lines, playerLine)
 		}
 	}
@@ -131,7 +180,7 @@ func (d *FormationsDialog) renderTeamPanel(teamName, formation string, players [
 }
 
 // renderPlayerLine renders a single player line with number, position, and rating.
-func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, focused bool) string {
+func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, focused, selected bool) string {
 	// Number
 	numStr := ""
 	if player.Number > 0 {
@@ -166,9 +215,12 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 		posStyle = dialogDimStyle
 		nameStyle = dialogDimStyle
 	}
+	if selected {
+		nameStyle = dialogHighlightStyle
+	}
 
 	// Render rating with badge for high ratings
-	ratingRendered := d.renderRating(player.Rating, focused)
+	ratingRendered := renderRating(player.Rating, focused)
 
 	return lipgloss.JoinHorizontal(lipgloss.Top,
 		numStyle.Render(numStr),
@@ -181,7 +233,7 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 }
 
 // renderRating renders the player rating with color styling.
-func (d *FormationsDialog) renderRating(rating string, focused bool) string {
+func renderRating(rating string, focused bool) string {
 	if rating == "" {
 		return "    "
 	}
@@ -212,3 +264,316 @@ func (d *FormationsDialog) renderRating(rating string, focused bool) string {
 	// Below average - dim
 	return dialogDimStyle.Render(ratingStr)
 }
+
+// playerMarkers holds the match events attached to a starting player.
+type playerMarkers struct {
+	subOutMinute int    // Minute the player was substituted off (0 if not)
+	replacedBy   string // Name of the substitute who came on
+	card         api.CardColor
+}
+
+// renderPitchView renders the starting XIs laid out on a pitch according to their
+// formations. When the dialog is wide enough both teams share the pitch, the home side
+// attacking to the right and the away side mirrored; otherwise only the focused team
+// is shown, attacking upwards.
+func (d *FormationsDialog) renderPitchView(width int) string {
+	homeRows := formationRows(d.homeFormation, d.homeStarting)
+	awayRows := formationRows(d.awayFormation, d.awayStarting)
+	if homeRows != nil && awayRows != nil && (width-3)/2/max(len(homeRows), len(awayRows)) >= minPitchCellWidth {
+		return d.renderFullPitch(width, homeRows, awayRows)
+	}
+	return d.renderTeamPitch(width)
+}
+
+// renderFullPitch renders both starting XIs on one pitch, each formation row as a column:
+// the home goalkeeper on the left, the away goalkeeper on the right, and the two sides
+// meeting at the halfway line.
+func (d *FormationsDialog) renderFullPitch(width int, homeRows, awayRows [][]api.PlayerInfo) string {
+	home := dialogDimStyle.Render(d.homeTeam)
+	away := dialogDimStyle.Render(d.awayTeam)
+	if d.focusedTeam == 0 {
+		home = dialogTeamStyle.Render(d.homeTeam)
+	} else {
+		away = dialogTeamStyle.Render(d.awayTeam)
+	}
+	if d.homeFormation != "" {
+		home += dialogDimStyle.Render(" " + d.homeFormation)
+	}
+	if d.awayFormation != "" {
+		away = dialogDimStyle.Render(d.awayFormation+" ") + away
+	}
+	header := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.PlaceHorizontal(width/2, lipgloss.Left, " "+home),
+		lipgloss.PlaceHorizontal(width-width/2, lipgloss.Right, away+" "),
+	)
+
+	markers := d.playerMarkers()
+	innerWidth := width - 2 // Pitch border
+	halfWidth := (innerWidth - 1) / 2
+
+	// Players are three lines tall with a blank line between them
+	height := 0
+	for _, row := range append(slices.Clone(homeRows), awayRows...) {
+		height = max(height, len(row)*4-1)
+	}
+
+	// column renders a formation row top to bottom; start is the index of its first
+	// player in the team's lineup, used to find the selected player
+	column := func(row []api.PlayerInfo, team, start, cellWidth int, mirrored bool) string {
+		var cells []string
+		for j := range row {
+			if mirrored {
+				j = len(row) - 1 - j
+			}
+			player := row[j]
+			selected := team == d.focusedTeam && start+j == d.selected
+			if len(cells) > 0 {
+				cells = append(cells, "")
+			}
+			cells = append(cells, d.renderPitchPlayer(player, markers[playerKey(player.ID, player.Name)], cellWidth, selected))
+		}
+		return lipgloss.PlaceVertical(height, lipgloss.Center, lipgloss.JoinVertical(lipgloss.Left, cells...))
+	}
+
+	// Each half spreads its rows evenly over its width
+	var homeColumns []string
+	start := 0
+	for _, row := range homeRows {
+		homeColumns = append(homeColumns, column(row, 0, start, halfWidth/len(homeRows), false))
+		start += len(row)
+	}
+
+	// The away side attacks to the left, so its rows run from the forwards to the goalkeeper
+	awayColumns := make([]string, len(awayRows))
+	start = 0
+	for i, row := range awayRows {
+		awayColumns[len(awayRows)-1-i] = column(row, 1, start, halfWidth/len(awayRows), true)
+		start += len(row)
+	}
+
+	halfway := dialogSeparatorStyle.Render(strings.TrimSuffix(strings.Repeat("│\n", height), "\n"))
+	pitch := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(neonDim).
+		Width(innerWidth).
+		Render(lipgloss.JoinHorizontal(lipgloss.Top,
+			lipgloss.PlaceHorizontal(halfWidth, lipgloss.Center, lipgloss.JoinHorizontal(lipgloss.Top, homeColumns...)),
+			halfway,
+			lipgloss.PlaceHorizontal(innerWidth-halfWidth-1, lipgloss.Center, lipgloss.JoinHorizontal(lipgloss.Top, awayColumns...)),
+		))
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, "", pitch, d.renderPitchLegend(width))
+}
+
+// renderTeamPitch renders the focused team's starting XI laid out on a pitch
+// according to its formation, attacking upwards.
+func (d *FormationsDialog) renderTeamPitch(width int) string {
+	teamName, formation, players := d.homeTeam, d.homeFormation, d.homeStarting
+	if d.focusedTeam == 1 {
+		teamName, formation, players = d.awayTeam, d.awayFormation, d.awayStarting
+	}
+
+	home := dialogDimStyle.Render(d.homeTeam)
+	away := dialogDimStyle.Render(d.awayTeam)
+	if d.focusedTeam == 0 {
+		home = dialogTeamStyle.Render(d.homeTeam)
+	} else {
+		away = dialogTeamStyle.Render(d.awayTeam)
+	}
+	header := lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(home + dialogDimStyle.Render("  │  ") + away)
+
+	formationStr := formation
+	if formationStr == "" {
+		formationStr = "Formation N/A"
+	}
+	lines := []string{header, dialogDimStyle.Width(width).Align(lipgloss.Center).Render(formationStr)}
+
+	rows := formationRows(formation, players)
+	if rows == nil {
+		msg := "Lineup not available"
+		if len(players) > 0 {
+			msg = "Pitch view not available for " + teamName + " (press v for the list)"
+		}
+		lines = append(lines, "", dialogDimStyle.Width(width).Align(lipgloss.Center).Render(msg))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	markers := d.playerMarkers()
+	innerWidth := width - 2 // Pitch border
+
+	// Index of the first player of each row, used to find the selected player
+	rowStart := make([]int, len(rows))
+	for i := 1; i < len(rows); i++ {
+		rowStart[i] = rowStart[i-1] + len(rows[i-1])
+	}
+
+	// Attackers at the top, goalkeeper at the bottom
+	var pitchLines []string
+	for i := len(rows) - 1; i >= 0; i-- {
+		cellWidth := innerWidth / len(rows[i])
+		var cells []string
+		for j, player := range rows[i] {
+			selected := rowStart[i]+j == d.selected
+			cells = append(cells, d.renderPitchPlayer(player, markers[playerKey(player.ID, player.Name)], cellWidth, selected))
+		}
+		pitchLines = append(pitchLines, lipgloss.PlaceHorizontal(innerWidth, lipgloss.Center, lipgloss.JoinHorizontal(lipgloss.Top, cells...)))
+		if i > 0 {
+			pitchLines = append(pitchLines, "")
+		}
+	}
+
+	pitch := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(neonDim).
+		Width(innerWidth).
+		Render(lipgloss.JoinVertical(lipgloss.Left, pitchLines...))
+
+	lines = append(lines, "", pitch, d.renderPitchLegend(width))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderPitchLegend explains the substitution and card markers of the pitch view.
+func (d *FormationsDialog) renderPitchLegend(width int) string {
+	legend := neonRedCardStyle.Render("▼") + dialogDimStyle.Render(" subbed off  ") +
+		dialogHeaderStyle.Render("▲") + dialogDimStyle.Render(" came on  ") +
+		cardMark(api.CardYellow) + dialogDimStyle.Render(" yellow  ") +
+		cardMark(api.CardRed) + dialogDimStyle.Render(" red")
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(legend)
+}
+
+// renderPitchPlayer renders a player cell: number and rating, name, then event markers.
+func (d *FormationsDialog) renderPitchPlayer(player api.PlayerInfo, markers playerMarkers, width int, selected bool) string {
+	cell := lipgloss.NewStyle().Width(width).Align(lipgloss.Center)
+
+	top := ""
+	if player.Number > 0 {
+		top = dialogHeaderStyle.Render(fmt.Sprintf("%d", player.Number))
+	}
+	if player.Rating != "" {
+		top += renderRating(player.Rating, true)
+	}
+
+	name := truncateName(pitchPlayerName(player.Name, width-2), width-2)
+	nameStyle := dialogContentStyle
+	if markers.card.IsRed() {
+		nameStyle = dialogDimStyle
+	}
+	if selected {
+		nameStyle = dialogHighlightStyle
+	}
+
+	var marks []string
+	if markers.card != "" {
+		marks = append(marks, cardMark(markers.card))
+	}
+	if markers.subOutMinute > 0 {
+		marks = append(marks, neonRedCardStyle.Render(fmt.Sprintf("▼%d'", markers.subOutMinute)))
+	}
+	bottom := strings.Join(marks, " ")
+	if markers.replacedBy != "" {
+		// Fill the remaining space with the incoming player
+		room := width - 2 - lipgloss.Width(bottom)
+		if bottom != "" {
+			room--
+		}
+		if room > 3 {
+			in := dialogHeaderStyle.Render("▲") + dialogDimStyle.Render(truncateName(pitchPlayerName(markers.replacedBy, room-1), room-1))
+			if bottom != "" {
+				bottom += " "
+			}
+			bottom += in
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		cell.Render(top),
+		cell.Render(nameStyle.Render(name)),
+		cell.Render(bottom),
+	)
+}
+
+// playerMarkers collects substitution and card markers for each player from the match events.
+// Players are keyed by ID, falling back to name when the ID is unknown.
+func (d *FormationsDialog) playerMarkers() map[string]playerMarkers {
+	markers := make(map[string]playerMarkers)
+	for _, event := range d.events {
+		switch event.Kind {
+		case api.EventKindSubstitution:
+			if event.PlayerOut == nil {
+				continue
+			}
+			key := playerKey(event.PlayerOut.ID, event.PlayerOut.Name)
+			m := markers[key]
+			m.subOutMinute = event.Minute
+			if event.PlayerIn != nil {
+				m.replacedBy = event.PlayerIn.Name
+			}
+			markers[key] = m
+		case api.EventKindCard:
+			name := ""
+			if event.Player != nil {
+				name = *event.Player
+			}
+			key := playerKey(event.PlayerID, name)
+			m := markers[key]
+			// Keep the most severe card shown
+			if m.card == "" || event.Card.IsRed() {
+				m.card = event.Card
+			}
+			markers[key] = m
+		}
+	}
+	return markers
+}
+
+// playerKey identifies a player across lineups and events.
+func playerKey(id int, name string) string {
+	if id > 0 {
+		return fmt.Sprintf("id:%d", id)
+	}
+	return "name:" + name
+}
+
+// pitchPlayerName shortens a player name to the surname when the full name does not fit.
+func pitchPlayerName(name string, maxLen int) string {
+	if len([]rune(name)) <= maxLen {
+		return name
+	}
+	parts := strings.Fields(name)
+	if len(parts) > 1 {
+		return parts[len(parts)-1]
+	}
+	return name
+}
+
+// formationRows splits a starting XI into rows (goalkeeper first) using the formation
+// string, e.g. "4-2-3-1" gives rows of 1, 4, 2, 3 and 1 players. Starters are listed
+// by FotMob from the goalkeeper forwards, so the order is preserved.
+// Returns nil when the formation does not match the number of players.
+func formationRows(formation string, players []api.PlayerInfo) [][]api.PlayerInfo {
+	if formation == "" || len(players) == 0 {
+		return nil
+	}
+
+	sizes := []int{1}
+	total := 1
+	for _, part := range strings.Split(formation, "-") {
+		var n int
+		if _, err := fmt.Sscanf(strings.TrimSpace(part), "%d", &n); err != nil || n <= 0 {
+			return nil
+		}
+		sizes = append(sizes, n)
+		total += n
+	}
+	if total != len(players) {
+		return nil
+	}
+
+	rows := make([][]api.PlayerInfo, 0, len(sizes))
+	start := 0
+	for _, n := range sizes {
+		rows = append(rows, players[start:start+n])
+		start += n
+	}
+	return rows
+}
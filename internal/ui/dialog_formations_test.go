@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestFormationRows(t *testing.T) {
+	xi := make([]api.PlayerInfo, 11)
+	for i := range xi {
+		xi[i] = api.PlayerInfo{ID: i + 1}
+	}
+
+	tests := []struct {
+		formation string
+		players   []api.PlayerInfo
+		want      []int // Players per row, goalkeeper first
+		desc      string
+	}{
+		{"4-3-3", xi, []int{1, 4, 3, 3}, "three lines"},
+		{"4-2-3-1", xi, []int{1, 4, 2, 3, 1}, "four lines"},
+		{"3-5-2", xi, []int{1, 3, 5, 2}, "back three"},
+		{"4-4-2", xi[:10], nil, "player sent off before kick-off"},
+		{"4-x-3", xi, nil, "invalid formation"},
+		{"", xi, nil, "no formation"},
+		{"4-3-3", nil, nil, "no players"},
+	}
+
+	for _, tt := range tests {
+		rows := formationRows(tt.formation, tt.players)
+		var got []int
+		for _, row := range rows {
+			got = append(got, len(row))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("formationRows(%q) = %v; want %v - %s", tt.formation, got, tt.want, tt.desc)
+		}
+		if len(rows) > 0 && (rows[0][0].ID != 1 || rows[len(rows)-1][len(rows[len(rows)-1])-1].ID != 11) {
+			t.Errorf("formationRows(%q) does not keep the lineup order - %s", tt.formation, tt.desc)
+		}
+	}
+}
+
+func TestPlayerMarkers(t *testing.T) {
+	name := func(s string) *string { return &s }
+	d := &FormationsDialog{events: []api.MatchEvent{
+		{Kind: api.EventKindSubstitution, Minute: 61, PlayerIn: &api.EventPlayer{ID: 12, Name: "Trossard"}, PlayerOut: &api.EventPlayer{ID: 7, Name: "Saka"}},
+		{Kind: api.EventKindSubstitution, Minute: 75, PlayerIn: &api.EventPlayer{Name: "Nelson"}, PlayerOut: &api.EventPlayer{Name: "Martinelli"}},
+		{Kind: api.EventKindSubstitution, Minute: 80, PlayerIn: &api.EventPlayer{ID: 14, Name: "Jesus"}},
+		{Kind: api.EventKindCard, Minute: 30, Player: name("Rice"), PlayerID: 41, Card: api.CardYellow},
+		{Kind: api.EventKindCard, Minute: 70, Player: name("Rice"), PlayerID: 41, Card: api.CardSecondYellow},
+		{Kind: api.EventKindCard, Minute: 50, Player: name("White"), PlayerID: 4, Card: api.CardRed},
+		{Kind: api.EventKindCard, Minute: 85, Player: name("White"), PlayerID: 4, Card: api.CardYellow},
+		{Kind: api.EventKindGoal, Minute: 20, Player: name("Saka"), PlayerID: 7},
+	}}
+
+	tests := []struct {
+		key  string
+		want playerMarkers
+		desc string
+	}{
+		{playerKey(7, "Saka"), playerMarkers{subOutMinute: 61, replacedBy: "Trossard"}, "substituted off"},
+		{playerKey(0, "Martinelli"), playerMarkers{subOutMinute: 75, replacedBy: "Nelson"}, "matched by name without an ID"},
+		{playerKey(41, "Rice"), playerMarkers{card: api.CardSecondYellow}, "second yellow replaces the first"},
+		{playerKey(4, "White"), playerMarkers{card: api.CardRed}, "red card kept over a later yellow"},
+		{playerKey(14, "Jesus"), playerMarkers{}, "substitute coming on"},
+	}
+
+	markers := d.playerMarkers()
+	for _, tt := range tests {
+		if got := markers[tt.key]; got != tt.want {
+			t.Errorf("playerMarkers()[%s] = %+v; want %+v - %s", tt.key, got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestRenderPitchView(t *testing.T) {
+	lineup := func(prefix string) []api.PlayerInfo {
+		players := make([]api.PlayerInfo, 11)
+		for i := range players {
+			players[i] = api.PlayerInfo{ID: i + 1, Name: fmt.Sprintf("%s%02d", prefix, i+1), Number: i + 1}
+		}
+		return players
+	}
+	d := NewFormationsDialog("Arsenal", "Chelsea", "4-3-3", "4-4-2", lineup("Hom"), lineup("Awa"), nil)
+
+	// column returns the display column of a name in the rendered view, -1 if absent.
+	column := func(view, name string) int {
+		for _, line := range strings.Split(view, "\n") {
+			if i := strings.Index(line, name); i >= 0 {
+				return lipgloss.Width(line[:i])
+			}
+		}
+		return -1
+	}
+
+	wide := d.renderPitchView(DefaultDialogMaxWidth)
+	homeGK, homeST := column(wide, "Hom01"), column(wide, "Hom11")
+	awayGK, awayST := column(wide, "Awa01"), column(wide, "Awa11")
+	if homeGK < 0 || awayGK < 0 || !(homeGK < homeST && homeST < awayST && awayST < awayGK) {
+		t.Errorf("full pitch columns: home GK %d, home ST %d, away ST %d, away GK %d; want increasing", homeGK, homeST, awayST, awayGK)
+	}
+
+	narrow := d.renderPitchView(60)
+	if column(narrow, "Hom01") < 0 || column(narrow, "Awa01") >= 0 {
+		t.Errorf("narrow pitch shows %q; want the focused home side only", narrow)
+	}
+}
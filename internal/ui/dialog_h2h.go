@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const h2hDialogID = "h2h"
+
+// HeadToHeadDialog displays the record and previous meetings between the match's teams.
+type HeadToHeadDialog struct {
+	homeTeam    string
+	awayTeam    string
+	homeTeamID  int
+	h2h         *api.HeadToHead
+	scrollIndex int
+	maxVisible  int
+}
+
+// NewHeadToHeadDialog creates a new head-to-head dialog.
+func NewHeadToHeadDialog(homeTeam, awayTeam string, homeTeamID int, h2h *api.HeadToHead) *HeadToHeadDialog {
+	return &HeadToHeadDialog{
+		homeTeam:   homeTeam,
+		awayTeam:   awayTeam,
+		homeTeamID: homeTeamID,
+		h2h:        h2h,
+		maxVisible: 15, // Meetings visible at once
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *HeadToHeadDialog) ID() string {
+	return h2hDialogID
+}
+
+// Update handles input for the head-to-head dialog.
+func (d *HeadToHeadDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.HeadToHead):
+			return d, DialogActionClose{}
+		case key.Matches(msg, km.Down):
+			if d.scrollIndex < len(d.h2h.Matches)-d.maxVisible {
+				d.scrollIndex++
+			}
+		case key.Matches(msg, km.Up):
+			if d.scrollIndex > 0 {
+				d.scrollIndex--
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the head-to-head record.
+func (d *HeadToHeadDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 90, 32)
+
+	content := d.renderContent(dialogWidth - 6)
+	return RenderDialogFrameWithHelp("Head to Head", content, scrollDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderContent renders the summary bar and the list of meetings.
+func (d *HeadToHeadDialog) renderContent(width int) string {
+	homeStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+	awayStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+
+	lines := []string{
+		lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(homeStyle.Render(d.homeTeam) + dialogDimStyle.Render("  vs  ") + awayStyle.Render(d.awayTeam)),
+		"",
+	}
+
+	// Record summary with a proportional bar
+	total := d.h2h.HomeWins + d.h2h.Draws + d.h2h.AwayWins
+	if total > 0 {
+		homeCols := d.h2h.HomeWins * width / total
+		awayCols := d.h2h.AwayWins * width / total
+		drawCols := width - homeCols - awayCols
+		lines = append(lines,
+			homeStyle.Render(strings.Repeat("█", homeCols))+
+				dialogDimStyle.Render(strings.Repeat("█", drawCols))+
+				awayStyle.Render(strings.Repeat("█", awayCols)))
+
+		home := homeStyle.Render(fmt.Sprintf("%d", d.h2h.HomeWins)) + dialogDimStyle.Render(" wins")
+		draws := dialogValueStyle.Render(fmt.Sprintf("%d", d.h2h.Draws)) + dialogDimStyle.Render(" draws")
+		away := awayStyle.Render(fmt.Sprintf("%d", d.h2h.AwayWins)) + dialogDimStyle.Render(" wins")
+		gap := width - lipgloss.Width(home) - lipgloss.Width(draws) - lipgloss.Width(away)
+		left := max(gap/2, 1)
+		lines = append(lines, home+strings.Repeat(" ", left)+draws+strings.Repeat(" ", max(gap-left, 1))+away)
+	} else {
+		lines = append(lines, dialogDimStyle.Render("No previous meetings"))
+	}
+	lines = append(lines, "")
+
+	// Previous meetings
+	lines = append(lines, dialogHeaderStyle.Render("Previous Meetings"))
+	lines = append(lines, dialogSeparatorStyle.Render(strings.Repeat("─", width)))
+	if len(d.h2h.Matches) == 0 {
+		lines = append(lines, dialogDimStyle.Render("Meeting details not available"))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	endIdx := min(d.scrollIndex+d.maxVisible, len(d.h2h.Matches))
+	for _, m := range d.h2h.Matches[d.scrollIndex:endIdx] {
+		lines = append(lines, d.renderMeeting(m, width))
+	}
+	if len(d.h2h.Matches) > d.maxVisible {
+		lines = append(lines, "")
+		lines = append(lines, dialogDimStyle.Render(fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, endIdx, len(d.h2h.Matches))))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderMeeting renders one previous meeting: date, competition and score, winner highlighted.
+func (d *HeadToHeadDialog) renderMeeting(m api.Match, width int) string {
+	const (
+		dateWidth  = 12
+		scoreWidth = 7
+	)
+
+	date := ""
+	if m.MatchTime != nil {
+		date = m.MatchTime.Local().Format("Jan 02 2006")
+	}
+
+	compWidth := min(width/4, 22)
+	teamWidth := max((width-dateWidth-compWidth-scoreWidth-2)/2, 6)
+
+	homeStyle, awayStyle := dialogValueStyle, dialogValueStyle
+	score := "-"
+	if m.HomeScore != nil && m.AwayScore != nil {
+		score = fmt.Sprintf("%d-%d", *m.HomeScore, *m.AwayScore)
+		// Colour the winner by the side they play on in the current match
+		winnerStyle := func(teamID int) lipgloss.Style {
+			if teamID == d.homeTeamID {
+				return lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+			}
+			return lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+		}
+		switch {
+		case *m.HomeScore > *m.AwayScore:
+			homeStyle = winnerStyle(m.HomeTeam.ID)
+		case *m.HomeScore < *m.AwayScore:
+			awayStyle = winnerStyle(m.AwayTeam.ID)
+		}
+	}
+
+	return dialogDimStyle.Width(dateWidth).Render(date) +
+		dialogDimStyle.Width(compWidth).Render(truncateName(m.League.Name, compWidth-1)) + " " +
+		homeStyle.Width(teamWidth).Align(lipgloss.Right).Render(truncateName(m.HomeTeam.Name, teamWidth)) +
+		dialogValueStyle.Width(scoreWidth).Align(lipgloss.Center).Render(score) +
+		awayStyle.Width(teamWidth).Render(truncateName(m.AwayTeam.Name, teamWidth))
+}
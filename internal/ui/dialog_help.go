@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const helpDialogID = "help"
+
+// HelpDialog lists every key binding of the active key map, grouped by view.
+type HelpDialog struct {
+	lines       []string
+	scrollIndex int
+	maxVisible  int
+}
+
+// NewHelpDialog creates a new help dialog for a key map.
+func NewHelpDialog(km keymap.KeyMap) *HelpDialog {
+	const keyWidth = 16
+
+	if !accessible {
+		km.Announcements.SetEnabled(false) // Accessible mode only
+	}
+
+	var lines []string
+	for i, group := range km.FullHelp() {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, dialogHeaderStyle.Render(group.Title))
+		for _, b := range group.Bindings {
+			keys := dialogValueStyle.Width(keyWidth).Render(b.Help().Key)
+			lines = append(lines, keys+dialogDimStyle.Render(b.Help().Desc))
+		}
+	}
+
+	return &HelpDialog{
+		lines:      lines,
+		maxVisible: 24, // Lines visible at once
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *HelpDialog) ID() string {
+	return helpDialogID
+}
+
+// Update handles input for the help dialog.
+func (d *HelpDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Help):
+			return d, DialogActionClose{}
+		case key.Matches(msg, km.Down):
+			if d.scrollIndex < len(d.lines)-d.maxVisible {
+				d.scrollIndex++
+			}
+		case key.Matches(msg, km.Up):
+			if d.scrollIndex > 0 {
+				d.scrollIndex--
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the key bindings.
+func (d *HelpDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 70, 34)
+
+	end := min(d.scrollIndex+d.maxVisible, len(d.lines))
+	lines := d.lines[d.scrollIndex:end]
+	if len(d.lines) > d.maxVisible {
+		lines = append(append([]string(nil), lines...), "", dialogDimStyle.Render(fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, end, len(d.lines))))
+	}
+
+	content := lipgloss.NewStyle().Width(dialogWidth - 6).Render(strings.Join(lines, "\n"))
+	return RenderDialogFrameWithHelp(constants.PanelKeyBindings, content, scrollDialogHelp(), dialogWidth, dialogHeight)
+}
@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const leagueStatsDialogID = "league_stats"
+
+// LeagueStatCategory describes one leaderboard: its title and value column label.
+type LeagueStatCategory struct {
+	Title string
+	Label string
+}
+
+// LeagueStatCategories are the leaderboards shown, in tab order.
+var LeagueStatCategories = []LeagueStatCategory{
+	{Title: "Top Scorers", Label: "Goals"},
+	{Title: "Assists", Label: "Assists"},
+	{Title: "Clean Sheets", Label: "CS"},
+}
+
+// LeagueStatLeaders returns the leaders of category i of LeagueStatCategories.
+func LeagueStatLeaders(stats *api.LeagueStats, i int) []api.StatLeader {
+	switch i {
+	case 0:
+		return stats.Scorers
+	case 1:
+		return stats.Assists
+	default:
+		return stats.CleanSheets
+	}
+}
+
+// LeagueStatsDialog displays a league's top scorers, assists and clean sheets.
+// Selecting a player requests their season profile.
+type LeagueStatsDialog struct {
+	leagueName string
+	stats      *api.LeagueStats
+	category   int // Index into LeagueStatCategories
+	selected   int
+}
+
+// NewLeagueStatsDialog creates a new league stats dialog.
+// stats may be nil if the lookup failed.
+func NewLeagueStatsDialog(leagueName string, stats *api.LeagueStats) *LeagueStatsDialog {
+	return &LeagueStatsDialog{
+		leagueName: leagueName,
+		stats:      stats,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *LeagueStatsDialog) ID() string {
+	return leagueStatsDialogID
+}
+
+// Update handles input for the league stats dialog.
+func (d *LeagueStatsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.LeagueLeaders):
+			return d, DialogActionClose{}
+		case key.Matches(msg, dialogTabKey, km.Right):
+			d.category = (d.category + 1) % len(LeagueStatCategories)
+			d.selected = 0
+		case key.Matches(msg, dialogShiftTabKey, km.Left):
+			d.category = (d.category + len(LeagueStatCategories) - 1) % len(LeagueStatCategories)
+			d.selected = 0
+		case key.Matches(msg, km.Down):
+			if d.stats != nil && d.selected < len(LeagueStatLeaders(d.stats, d.category))-1 {
+				d.selected++
+			}
+		case key.Matches(msg, km.Up):
+			if d.selected > 0 {
+				d.selected--
+			}
+		case key.Matches(msg, km.Select):
+			if d.stats == nil {
+				break
+			}
+			leaders := LeagueStatLeaders(d.stats, d.category)
+			if d.selected < len(leaders) {
+				p := leaders[d.selected]
+				return d, DialogActionPlayerProfile{PlayerID: p.PlayerID, Name: p.Name}
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the league stats.
+func (d *LeagueStatsDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 80, 32)
+	contentWidth := dialogWidth - 6
+
+	var content string
+	if d.stats == nil {
+		content = dialogDimStyle.Render(fmt.Sprintf("League stats not available for %s", d.leagueName))
+	} else {
+		var tabs []string
+		for i, c := range LeagueStatCategories {
+			if i == d.category {
+				tabs = append(tabs, dialogHighlightStyle.Render("["+c.Title+"]"))
+			} else {
+				tabs = append(tabs, dialogDimStyle.Render(" "+c.Title+" "))
+			}
+		}
+		content = lipgloss.JoinVertical(lipgloss.Left,
+			strings.Join(tabs, " "),
+			"",
+			RenderStatLeaders(LeagueStatLeaders(d.stats, d.category), LeagueStatCategories[d.category].Label, contentWidth, d.selected),
+		)
+	}
+	return RenderDialogFrameWithHelp(d.leagueName+" Leaders", content, leagueStatsDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// RenderStatLeaders renders a leaderboard as rank, player, team and value columns.
+// selected highlights a row; pass -1 for none. Shared by the league stats dialog
+// and the `golazo scorers` command.
+func RenderStatLeaders(leaders []api.StatLeader, label string, width, selected int) string {
+	if len(leaders) == 0 {
+		return dialogDimStyle.Render("No data available")
+	}
+
+	const (
+		rankWidth  = 4
+		valueWidth = 8
+	)
+	nameWidth := max((width-rankWidth-valueWidth-2)*55/100, 8)
+	teamWidth := max(width-rankWidth-valueWidth-nameWidth-2, 6)
+
+	lines := []string{
+		dialogHeaderStyle.Width(rankWidth).Align(lipgloss.Right).Render("#") + "  " +
+			dialogHeaderStyle.Width(nameWidth).Render("Player") +
+			dialogHeaderStyle.Width(teamWidth).Render("Team") +
+			dialogHeaderStyle.Width(valueWidth).Align(lipgloss.Right).Render(label),
+		dialogSeparatorStyle.Render(strings.Repeat("─", width)),
+	}
+
+	for i, l := range leaders {
+		nameStyle := dialogContentStyle
+		gutter := "  "
+		if i == selected {
+			nameStyle = dialogHighlightStyle
+			gutter = dialogHighlightStyle.Render(" ▸")
+		}
+		lines = append(lines,
+			dialogDimStyle.Width(rankWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", l.Rank))+gutter+
+				nameStyle.Width(nameWidth).Render(truncateName(l.Name, nameWidth-1))+
+				dialogDimStyle.Width(teamWidth).Render(truncateName(l.Team, teamWidth-1))+
+				dialogValueStyle.Width(valueWidth).Align(lipgloss.Right).Render(fmt.Sprintf("%d", l.Value)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,231 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const matchPlayersDialogID = "match_players"
+
+// matchPlayer is a player mentioned in the match events, with a summary of their involvement.
+type matchPlayer struct {
+	id      int // 0 when only the name is known (e.g., assists)
+	name    string
+	isHome  bool
+	goals   int
+	assists int
+	card    api.CardColor
+	subIn   bool
+	subOut  bool
+}
+
+// MatchPlayersDialog lists every player involved in the match events.
+// Selecting a player requests their season profile.
+type MatchPlayersDialog struct {
+	homeTeam    string
+	awayTeam    string
+	players     []matchPlayer
+	selected    int
+	scrollIndex int
+	maxVisible  int
+}
+
+// NewMatchPlayersDialog creates a new dialog listing the players found in the match events.
+func NewMatchPlayersDialog(homeTeam, awayTeam string, homeTeamID int, events []api.MatchEvent) *MatchPlayersDialog {
+	return &MatchPlayersDialog{
+		homeTeam:   homeTeam,
+		awayTeam:   awayTeam,
+		players:    collectMatchPlayers(homeTeamID, events),
+		maxVisible: 20,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *MatchPlayersDialog) ID() string {
+	return matchPlayersDialogID
+}
+
+// Update handles input for the match players dialog.
+func (d *MatchPlayersDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Players):
+			return d, DialogActionClose{}
+		case key.Matches(msg, km.Down):
+			if d.selected < len(d.players)-1 {
+				d.selected++
+			}
+			if d.selected >= d.scrollIndex+d.maxVisible {
+				d.scrollIndex = d.selected - d.maxVisible + 1
+			}
+		case key.Matches(msg, km.Up):
+			if d.selected > 0 {
+				d.selected--
+			}
+			if d.selected < d.scrollIndex {
+				d.scrollIndex = d.selected
+			}
+		case key.Matches(msg, km.Select):
+			if d.selected < len(d.players) {
+				p := d.players[d.selected]
+				return d, DialogActionPlayerProfile{PlayerID: p.id, Name: p.name}
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the match players list.
+func (d *MatchPlayersDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 72, 32)
+
+	content := d.renderContent(dialogWidth - 6)
+	return RenderDialogFrameWithHelp("Match Players", content, matchPlayersDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderContent renders the visible player rows.
+func (d *MatchPlayersDialog) renderContent(width int) string {
+	if len(d.players) == 0 {
+		return dialogDimStyle.Render("No player events available")
+	}
+
+	teamWidth := max(len(d.homeTeam), len(d.awayTeam))
+	teamWidth = min(teamWidth, 12)
+
+	var lines []string
+	endIdx := min(d.scrollIndex+d.maxVisible, len(d.players))
+	for i := d.scrollIndex; i < endIdx; i++ {
+		p := d.players[i]
+
+		team := d.awayTeam
+		teamStyle := lipgloss.NewStyle().Foreground(neonRed)
+		if p.isHome {
+			team = d.homeTeam
+			teamStyle = lipgloss.NewStyle().Foreground(neonCyan)
+		}
+
+		nameStyle := dialogContentStyle
+		prefix := "  "
+		if i == d.selected {
+			nameStyle = dialogHighlightStyle
+			prefix = dialogHighlightStyle.Render("▸ ")
+		}
+
+		summary := renderMatchPlayerSummary(p)
+		nameWidth := width - 2 - teamWidth - 2 - lipgloss.Width(summary) - 1
+		name := truncateName(p.name, max(nameWidth, 4))
+
+		lines = append(lines, prefix+
+			teamStyle.Width(teamWidth).Render(truncateName(team, teamWidth))+"  "+
+			nameStyle.Width(max(nameWidth, 4)).Render(name)+" "+summary)
+	}
+
+	if len(d.players) > d.maxVisible {
+		lines = append(lines, "")
+		lines = append(lines, dialogDimStyle.Render(fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, endIdx, len(d.players))))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderMatchPlayerSummary renders compact markers for a player's goals, assists, cards and substitutions.
+func renderMatchPlayerSummary(p matchPlayer) string {
+	var parts []string
+	if p.goals > 0 {
+		parts = append(parts, neonValueStyle.Render(strings.Repeat("●", p.goals)))
+	}
+	if p.assists > 0 {
+		parts = append(parts, dialogValueStyle.Render(strings.Repeat("A", p.assists)))
+	}
+	switch {
+	case p.card.IsRed():
+		parts = append(parts, cardMark(api.CardRed))
+	case p.card == api.CardYellow:
+		parts = append(parts, cardMark(api.CardYellow))
+	}
+	if p.subIn {
+		parts = append(parts, dialogHeaderStyle.Render("▲"))
+	}
+	if p.subOut {
+		parts = append(parts, neonRedCardStyle.Render("▼"))
+	}
+	return strings.Join(parts, " ")
+}
+
+// collectMatchPlayers gathers the players mentioned in the events in order of first appearance,
+// merging entries known by ID with those known only by name.
+func collectMatchPlayers(homeTeamID int, events []api.MatchEvent) []matchPlayer {
+	var players []matchPlayer
+	index := make(map[string]int)
+
+	find := func(id int, name string, isHome bool) *matchPlayer {
+		if name == "" && id == 0 {
+			return nil
+		}
+		if i, ok := index[playerKey(id, name)]; ok {
+			return &players[i]
+		}
+		if i, ok := index[playerKey(0, name)]; ok && name != "" {
+			// Upgrade a name-only entry once the ID is known
+			if id > 0 && players[i].id == 0 {
+				players[i].id = id
+				index[playerKey(id, name)] = i
+			}
+			return &players[i]
+		}
+		players = append(players, matchPlayer{id: id, name: name, isHome: isHome})
+		i := len(players) - 1
+		index[playerKey(id, name)] = i
+		if name != "" {
+			index[playerKey(0, name)] = i
+		}
+		return &players[i]
+	}
+
+	for _, e := range events {
+		isHome := e.Team.ID == homeTeamID
+		var name string
+		if e.Player != nil {
+			name = *e.Player
+		}
+
+		switch e.Kind {
+		case api.EventKindGoal:
+			if p := find(e.PlayerID, name, isHome); p != nil && !e.IsOwnGoal {
+				p.goals++
+			}
+			if e.Assist != nil {
+				if p := find(0, *e.Assist, isHome); p != nil {
+					p.assists++
+				}
+			}
+		case api.EventKindCard:
+			if p := find(e.PlayerID, name, isHome); p != nil && (p.card == "" || e.Card.IsRed()) {
+				p.card = e.Card
+			}
+		case api.EventKindSubstitution:
+			if e.PlayerIn != nil {
+				if p := find(e.PlayerIn.ID, e.PlayerIn.Name, isHome); p != nil {
+					p.subIn = true
+				}
+			}
+			if e.PlayerOut != nil {
+				if p := find(e.PlayerOut.ID, e.PlayerOut.Name, isHome); p != nil {
+					p.subOut = true
+				}
+			}
+		case api.EventKindDisallowedGoal, api.EventKindMissedPenalty, api.EventKindVAR:
+			find(e.PlayerID, name, isHome)
+		}
+	}
+
+	return players
+}
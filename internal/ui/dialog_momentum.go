@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const momentumDialogID = "momentum"
+
+const (
+	momentumHalfHeight = 4 // Rows per team above/below the momentum axis
+	xgChartHeight      = 8 // Rows in the cumulative xG chart
+	chartLabelWidth    = 6 // Left margin for chart axis labels
+)
+
+// Eighth-block characters used to draw fractional bar heights.
+var barBlocks = []string{"▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+
+// MomentumDialog displays the match momentum chart and the cumulative xG race.
+type MomentumDialog struct {
+	homeTeam string
+	awayTeam string
+	momentum []api.MomentumPoint
+	shots    []api.Shot
+	homeXG   *float64
+	awayXG   *float64
+}
+
+// NewMomentumDialog creates a new momentum and xG dialog.
+func NewMomentumDialog(homeTeam, awayTeam string, momentum []api.MomentumPoint, shots []api.Shot, homeXG, awayXG *float64) *MomentumDialog {
+	return &MomentumDialog{
+		homeTeam: homeTeam,
+		awayTeam: awayTeam,
+		momentum: momentum,
+		shots:    shots,
+		homeXG:   homeXG,
+		awayXG:   awayXG,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *MomentumDialog) ID() string {
+	return momentumDialogID
+}
+
+// Update handles input for the momentum dialog.
+func (d *MomentumDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	if msg, ok := msg.(tea.KeyMsg); ok && closesDialog(msg, keymap.Current().Momentum) {
+		return d, DialogActionClose{}
+	}
+	return d, nil
+}
+
+// View renders the momentum chart and xG race.
+func (d *MomentumDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+
+	content := d.renderContent(dialogWidth - 6)
+	return RenderDialogFrameWithHelp("Momentum & xG", content, keymap.HelpLine(closeHelp()), dialogWidth, dialogHeight)
+}
+
+// renderContent renders the dialog content.
+func (d *MomentumDialog) renderContent(width int) string {
+	var lines []string
+
+	lines = append(lines, d.renderTeamHeader(width))
+	lines = append(lines, "")
+
+	// Momentum chart
+	lines = append(lines, dialogHeaderStyle.Render("Momentum"))
+	if len(d.momentum) == 0 {
+		lines = append(lines, dialogDimStyle.Render("Momentum not available"))
+	} else {
+		lines = append(lines, d.renderMomentumChart(width)...)
+	}
+	lines = append(lines, "")
+
+	// Cumulative xG race
+	lines = append(lines, dialogHeaderStyle.Render("Expected Goals (xG)"))
+	if len(d.shots) == 0 {
+		lines = append(lines, dialogDimStyle.Render("xG data not available"))
+	} else {
+		lines = append(lines, d.renderXGRace(width)...)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderTeamHeader renders team names with their xG totals.
+func (d *MomentumDialog) renderTeamHeader(width int) string {
+	home := dialogTeamStyle.Render(d.homeTeam)
+	away := lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render(d.awayTeam)
+	if d.homeXG != nil && d.awayXG != nil {
+		home += dialogDimStyle.Render(fmt.Sprintf(" %.2f xG", *d.homeXG))
+		away = dialogDimStyle.Render(fmt.Sprintf("%.2f xG ", *d.awayXG)) + away
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(home + "  vs  " + away)
+}
+
+// chartMinutes returns the number of minutes covered by the charts (90 or more).
+func (d *MomentumDialog) chartMinutes() float64 {
+	maxMinute := 90.0
+	for _, p := range d.momentum {
+		maxMinute = math.Max(maxMinute, p.Minute)
+	}
+	for _, s := range d.shots {
+		maxMinute = math.Max(maxMinute, float64(s.Minute))
+	}
+	return maxMinute
+}
+
+// renderMomentumChart draws home momentum as bars above the axis and away momentum below it.
+// Goals are marked on the axis.
+func (d *MomentumDialog) renderMomentumChart(width int) []string {
+	cols := width - chartLabelWidth
+	if cols < 10 {
+		return []string{dialogDimStyle.Render("Not enough space")}
+	}
+	maxMinute := d.chartMinutes()
+
+	// Average momentum per column
+	sums := make([]float64, cols)
+	counts := make([]int, cols)
+	for _, p := range d.momentum {
+		col := minuteColumn(p.Minute, maxMinute, cols)
+		sums[col] += p.Value
+		counts[col]++
+	}
+	values := make([]float64, cols)
+	for c := range values {
+		if counts[c] > 0 {
+			values[c] = sums[c] / float64(counts[c])
+		} else if c > 0 {
+			values[c] = values[c-1] // Carry forward across gaps
+		}
+	}
+
+	homeStyle := lipgloss.NewStyle().Foreground(neonCyan)
+	awayStyle := lipgloss.NewStyle().Foreground(neonRed)
+	var lines []string
+
+	// Home bars grow upwards from the axis
+	for row := momentumHalfHeight - 1; row >= 0; row-- {
+		var b strings.Builder
+		for _, v := range values {
+			if v <= 0 {
+				b.WriteString(" ")
+				continue
+			}
+			eighths := int(math.Round(v / 100 * momentumHalfHeight * 8))
+			fill := eighths - row*8
+			switch {
+			case fill >= 8:
+				b.WriteString(homeStyle.Render("█"))
+			case fill > 0:
+				b.WriteString(homeStyle.Render(barBlocks[fill-1]))
+			default:
+				b.WriteString(" ")
+			}
+		}
+		label := ""
+		if row == momentumHalfHeight-1 {
+			label = truncateName(d.homeTeam, chartLabelWidth-1)
+		}
+		lines = append(lines, chartLabel(label)+b.String())
+	}
+
+	// Axis with goal markers
+	axis := make([]string, cols)
+	for c := range axis {
+		axis[c] = dialogSeparatorStyle.Render("─")
+	}
+	for _, s := range d.shots {
+		if s.Outcome != api.ShotGoal {
+			continue
+		}
+		col := minuteColumn(float64(s.Minute), maxMinute, cols)
+		if s.IsHome {
+			axis[col] = homeStyle.Bold(true).Render("●")
+		} else {
+			axis[col] = awayStyle.Bold(true).Render("●")
+		}
+	}
+	lines = append(lines, chartLabel("")+strings.Join(axis, ""))
+
+	// Away bars grow downwards from the axis (upper-half blocks approximate fractions)
+	for row := 0; row < momentumHalfHeight; row++ {
+		var b strings.Builder
+		for _, v := range values {
+			if v >= 0 {
+				b.WriteString(" ")
+				continue
+			}
+			eighths := int(math.Round(-v / 100 * momentumHalfHeight * 8))
+			fill := eighths - row*8
+			switch {
+			case fill >= 8:
+				b.WriteString(awayStyle.Render("█"))
+			case fill >= 4:
+				b.WriteString(awayStyle.Render("▀"))
+			case fill > 0:
+				b.WriteString(awayStyle.Render("▔"))
+			default:
+				b.WriteString(" ")
+			}
+		}
+		label := ""
+		if row == momentumHalfHeight-1 {
+			label = truncateName(d.awayTeam, chartLabelWidth-1)
+		}
+		lines = append(lines, chartLabel(label)+b.String())
+	}
+
+	lines = append(lines, chartLabel("")+renderMinuteTicks(maxMinute, cols))
+	return lines
+}
+
+// renderXGRace draws the cumulative xG of both teams over time as step lines.
+func (d *MomentumDialog) renderXGRace(width int) []string {
+	cols := width - chartLabelWidth
+	if cols < 10 {
+		return []string{dialogDimStyle.Render("Not enough space")}
+	}
+	maxMinute := d.chartMinutes()
+
+	// Cumulative xG at the end of each column
+	homeCum := make([]float64, cols)
+	awayCum := make([]float64, cols)
+	for _, s := range d.shots {
+		if s.IsOwnGoal {
+			continue
+		}
+		col := minuteColumn(float64(s.Minute), maxMinute, cols)
+		if s.IsHome {
+			homeCum[col] += s.XG
+		} else {
+			awayCum[col] += s.XG
+		}
+	}
+	for c := 1; c < cols; c++ {
+		homeCum[c] += homeCum[c-1]
+		awayCum[c] += awayCum[c-1]
+	}
+
+	maxXG := math.Max(math.Max(homeCum[cols-1], awayCum[cols-1]), 0.5)
+	toRow := func(xg float64) int {
+		return int(math.Round(xg / maxXG * float64(xgChartHeight-1)))
+	}
+
+	// Grid of styled cells, row 0 at the bottom
+	grid := make([][]string, xgChartHeight)
+	for r := range grid {
+		grid[r] = make([]string, cols)
+		for c := range grid[r] {
+			grid[r][c] = " "
+		}
+	}
+	homeStyle := lipgloss.NewStyle().Foreground(neonCyan)
+	awayStyle := lipgloss.NewStyle().Foreground(neonRed)
+	homeRows := plotStepLine(grid, homeCum, toRow, homeStyle)
+	awayRows := plotStepLine(grid, awayCum, toRow, awayStyle)
+
+	// Mark columns where both lines meet
+	for c := 0; c < cols; c++ {
+		if homeRows[c] == awayRows[c] {
+			grid[homeRows[c]][c] = dialogValueStyle.Render("━")
+		}
+	}
+
+	var lines []string
+	for r := xgChartHeight - 1; r >= 0; r-- {
+		label := ""
+		switch r {
+		case xgChartHeight - 1:
+			label = fmt.Sprintf("%.1f", maxXG)
+		case 0:
+			label = "0.0"
+		}
+		lines = append(lines, chartLabel(label)+strings.Join(grid[r], ""))
+	}
+	lines = append(lines, chartLabel("")+renderMinuteTicks(maxMinute, cols))
+
+	legend := homeStyle.Render("━ ") + dialogValueStyle.Render(fmt.Sprintf("%s %.2f", d.homeTeam, homeCum[cols-1])) +
+		"    " + awayStyle.Render("━ ") + dialogValueStyle.Render(fmt.Sprintf("%s %.2f", d.awayTeam, awayCum[cols-1]))
+	lines = append(lines, "")
+	lines = append(lines, lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(legend))
+
+	return lines
+}
+
+// plotStepLine draws a cumulative series into the grid and returns the row used per column.
+// Rises are drawn as vertical segments so each xG jump is visible.
+func plotStepLine(grid [][]string, series []float64, toRow func(float64) int, style lipgloss.Style) []int {
+	rows := make([]int, len(series))
+	prev := 0
+	for c, v := range series {
+		row := toRow(v)
+		rows[c] = row
+		if row > prev {
+			grid[prev][c] = style.Render("┘")
+			for r := prev + 1; r < row; r++ {
+				grid[r][c] = style.Render("│")
+			}
+			grid[row][c] = style.Render("┌")
+		} else {
+			grid[row][c] = style.Render("─")
+		}
+		prev = row
+	}
+	return rows
+}
+
+// minuteColumn maps a match minute to a chart column.
+func minuteColumn(minute, maxMinute float64, cols int) int {
+	col := int(minute / maxMinute * float64(cols))
+	if col < 0 {
+		return 0
+	}
+	if col >= cols {
+		return cols - 1
+	}
+	return col
+}
+
+// renderMinuteTicks renders minute labels every 15 minutes below a chart.
+func renderMinuteTicks(maxMinute float64, cols int) string {
+	ticks := []rune(strings.Repeat(" ", cols))
+	for m := 0; m <= int(maxMinute); m += 15 {
+		label := fmt.Sprintf("%d'", m)
+		col := minuteColumn(float64(m), maxMinute, cols)
+		if col+len(label) > cols {
+			col = cols - len(label)
+		}
+		for i, r := range label {
+			ticks[col+i] = r
+		}
+	}
+	return dialogDimStyle.Render(string(ticks))
+}
+
+// chartLabel renders a fixed-width axis label.
+func chartLabel(label string) string {
+	return dialogDimStyle.Width(chartLabelWidth).Render(label)
+}
+
+// truncateName shortens a name to fit maxLen characters.
+func truncateName(name string, maxLen int) string {
+	runes := []rune(name)
+	if len(runes) <= maxLen {
+		return name
+	}
+	return string(runes[:maxLen])
+}
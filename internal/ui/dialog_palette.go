@@ -0,0 +1,211 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const paletteDialogID = "palette"
+
+// CommandKind is what a command palette entry jumps to.
+type CommandKind int
+
+const (
+	CommandView   CommandKind = iota // A view, by Name
+	CommandDialog                    // A dialog of the current match, by key map action Name
+	CommandMatch                     // A match, by ID
+	CommandTeam                      // A team's overview, by ID
+	CommandLeague                    // A league's standings, by ID
+	CommandTab                       // A settings tab, by Name
+	CommandTheme                     // A theme previewed in settings, by Name
+)
+
+// PaletteCommand is an entry of the command palette.
+type PaletteCommand struct {
+	Kind  CommandKind
+	Title string // Searched and shown
+	Hint  string // Shown dimmed beside the title, e.g. "team"
+	ID    int
+	Name  string
+}
+
+// paletteResult is a command matching the query, with the matched title runes.
+type paletteResult struct {
+	command PaletteCommand
+	matched []int
+}
+
+// PaletteDialog finds commands by fuzzy search on their titles, as the list filter does.
+type PaletteDialog struct {
+	input      textinput.Model
+	commands   []PaletteCommand
+	matchIDs   bool // A number typed in can be opened as a match ID
+	results    []paletteResult
+	cursor     int
+	offset     int
+	maxVisible int
+}
+
+// NewPaletteDialog creates a new command palette over commands. With matchIDs,
+// typing a number also offers the match with that ID.
+func NewPaletteDialog(commands []PaletteCommand, matchIDs bool) *PaletteDialog {
+	cursorStyle, promptStyle := FilterInputStyles()
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "team, league, match, dialog or setting"
+	input.PromptStyle = promptStyle
+	input.Cursor.Style = cursorStyle
+	input.Focus()
+
+	d := &PaletteDialog{
+		input:      input,
+		commands:   commands,
+		matchIDs:   matchIDs,
+		maxVisible: 14, // Results visible at once
+	}
+	d.filter()
+	return d
+}
+
+// ID returns the dialog identifier.
+func (d *PaletteDialog) ID() string {
+	return paletteDialogID
+}
+
+// Update handles input for the command palette.
+func (d *PaletteDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return d, nil
+	}
+
+	up, down, sel, back := paletteKeys()
+	switch {
+	case key.Matches(keyMsg, back):
+		return d, DialogActionClose{}
+	case key.Matches(keyMsg, sel):
+		if len(d.results) == 0 {
+			return d, nil
+		}
+		return d, DialogActionCommand{Command: d.results[d.cursor].command}
+	case key.Matches(keyMsg, up):
+		d.moveCursor(-1)
+		return d, nil
+	case key.Matches(keyMsg, down):
+		d.moveCursor(1)
+		return d, nil
+	}
+
+	query := d.input.Value()
+	d.input, _ = d.input.Update(msg)
+	if d.input.Value() != query {
+		d.filter()
+	}
+	return d, nil
+}
+
+// moveCursor moves the selected result, scrolling to keep it visible.
+func (d *PaletteDialog) moveCursor(delta int) {
+	if len(d.results) == 0 {
+		return
+	}
+	d.cursor = max(min(d.cursor+delta, len(d.results)-1), 0)
+	if d.cursor < d.offset {
+		d.offset = d.cursor
+	} else if d.cursor >= d.offset+d.maxVisible {
+		d.offset = d.cursor - d.maxVisible + 1
+	}
+}
+
+// filter ranks the commands against the query, best first. An empty query lists them all.
+func (d *PaletteDialog) filter() {
+	query := strings.TrimSpace(d.input.Value())
+	d.results = d.results[:0]
+	d.cursor, d.offset = 0, 0
+
+	if id, err := strconv.Atoi(query); err == nil && id > 0 && d.matchIDs {
+		d.results = append(d.results, paletteResult{command: PaletteCommand{
+			Kind:  CommandMatch,
+			Title: fmt.Sprintf("Match %d", id),
+			Hint:  "match ID",
+			ID:    id,
+		}})
+	}
+
+	if query == "" {
+		for _, c := range d.commands {
+			d.results = append(d.results, paletteResult{command: c})
+		}
+		return
+	}
+
+	titles := make([]string, len(d.commands))
+	for i, c := range d.commands {
+		titles[i] = c.Title
+	}
+	for _, rank := range list.DefaultFilter(query, titles) {
+		d.results = append(d.results, paletteResult{command: d.commands[rank.Index], matched: rank.MatchedIndexes})
+	}
+}
+
+// View renders the query and the matching commands.
+func (d *PaletteDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 80, 24)
+	contentWidth := dialogWidth - 6
+
+	lines := []string{d.input.View(), ""}
+	if len(d.results) == 0 {
+		lines = append(lines, dialogDimStyle.Render(constants.EmptyNoCommands))
+	}
+
+	end := min(d.offset+d.maxVisible, len(d.results))
+	for i := d.offset; i < end; i++ {
+		lines = append(lines, d.renderResult(d.results[i], i == d.cursor, contentWidth))
+	}
+	if len(d.results) > d.maxVisible {
+		lines = append(lines, "", dialogDimStyle.Render(fmt.Sprintf("(%d-%d of %d)", d.offset+1, end, len(d.results))))
+	}
+
+	content := lipgloss.NewStyle().Width(contentWidth).Render(strings.Join(lines, "\n"))
+	return RenderDialogFrameWithHelp(constants.PanelCommandPalette, content, paletteDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderResult renders one result: the title with the matched runes highlighted,
+// and the hint aligned right.
+func (d *PaletteDialog) renderResult(r paletteResult, selected bool, width int) string {
+	prefix, titleStyle := "  ", dialogValueStyle
+	if selected {
+		prefix, titleStyle = "▸ ", dialogHighlightStyle
+	}
+
+	hint := dialogDimStyle.Render(r.command.Hint)
+	titleWidth := width - lipgloss.Width(prefix) - lipgloss.Width(hint) - 2
+	runes := []rune(r.command.Title)
+	if len(runes) > titleWidth && titleWidth > 1 {
+		runes = append(runes[:titleWidth-1], '…')
+	}
+
+	var title strings.Builder
+	matched := make(map[int]bool, len(r.matched))
+	for _, i := range r.matched {
+		matched[i] = true
+	}
+	for i, c := range runes {
+		if matched[i] {
+			title.WriteString(titleStyle.Underline(true).Render(string(c)))
+		} else {
+			title.WriteString(titleStyle.Render(string(c)))
+		}
+	}
+
+	gap := max(width-lipgloss.Width(prefix)-lipgloss.Width(title.String())-lipgloss.Width(hint), 1)
+	return titleStyle.Render(prefix) + title.String() + strings.Repeat(" ", gap) + hint
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPaletteDialogFilter(t *testing.T) {
+	commands := []PaletteCommand{
+		{Kind: CommandView, Title: "Live Matches", Name: "live"},
+		{Kind: CommandDialog, Title: "Standings", Name: "standings"},
+		{Kind: CommandMatch, Title: "Arsenal vs Chelsea", ID: 4506263},
+		{Kind: CommandTeam, Title: "Arsenal", ID: 9825},
+		{Kind: CommandLeague, Title: "Premier League", ID: 47},
+		{Kind: CommandTheme, Title: "Theme: mono", Name: "mono"},
+	}
+
+	tests := []struct {
+		query    string
+		matchIDs bool
+		want     []string // Result titles, best first
+		desc     string
+	}{
+		{"", false, []string{"Live Matches", "Standings", "Arsenal vs Chelsea", "Arsenal", "Premier League", "Theme: mono"}, "empty query lists every command in order"},
+		{"arsenal", false, []string{"Arsenal", "Arsenal vs Chelsea"}, "closest match first"},
+		{"prem", false, []string{"Premier League"}, "prefix of a title"},
+		{"stds", false, []string{"Standings"}, "fuzzy match"},
+		{"zzz", false, nil, "no match"},
+		{"47", true, []string{"Match 47"}, "number offered as a match ID"},
+		{"47", false, nil, "match IDs only in the match views"},
+	}
+
+	for _, tt := range tests {
+		d := NewPaletteDialog(commands, tt.matchIDs)
+		d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.query)})
+
+		var got []string
+		for _, r := range d.results {
+			got = append(got, r.command.Title)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("results for %q = %q; want %q - %s", tt.query, got, tt.want, tt.desc)
+		}
+	}
+}
+
+func TestPaletteDialogSelect(t *testing.T) {
+	commands := []PaletteCommand{
+		{Kind: CommandView, Title: "Live Matches", Name: "live"},
+		{Kind: CommandView, Title: "Settings", Name: "settings"},
+		{Kind: CommandTeam, Title: "Arsenal", ID: 9825},
+	}
+
+	tests := []struct {
+		keys []tea.KeyMsg
+		want PaletteCommand
+		desc string
+	}{
+		{nil, commands[0], "first result selected"},
+		{[]tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyDown}}, commands[2], "cursor moves down"},
+		{[]tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyDown}, {Type: tea.KeyDown}, {Type: tea.KeyUp}}, commands[1], "cursor stays within the results"},
+		{[]tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyRunes, Runes: []rune("ars")}}, commands[2], "typing resets the cursor"},
+		{[]tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("123")}}, PaletteCommand{Kind: CommandMatch, Title: "Match 123", Hint: "match ID", ID: 123}, "match ID"},
+	}
+
+	for _, tt := range tests {
+		d := NewPaletteDialog(commands, true)
+		for _, k := range tt.keys {
+			d.Update(k)
+		}
+		_, action := d.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		got, ok := action.(DialogActionCommand)
+		if !ok || got.Command != tt.want {
+			t.Errorf("Enter = %+v; want %+v - %s", action, tt.want, tt.desc)
+		}
+	}
+
+	d := NewPaletteDialog(commands, false)
+	d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("zzz")})
+	if _, action := d.Update(tea.KeyMsg{Type: tea.KeyEnter}); action != nil {
+		t.Errorf("Enter without results = %+v; want nothing", action)
+	}
+}
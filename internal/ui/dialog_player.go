@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const playerDialogID = "player"
+
+// PlayerDialog displays a player's statistics and events for a single match.
+type PlayerDialog struct {
+	player   api.PlayerInfo
+	teamName string
+	events   []api.MatchEvent
+}
+
+// NewPlayerDialog creates a new player match details dialog.
+func NewPlayerDialog(player api.PlayerInfo, teamName string, events []api.MatchEvent) *PlayerDialog {
+	return &PlayerDialog{
+		player:   player,
+		teamName: teamName,
+		events:   events,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *PlayerDialog) ID() string {
+	return playerDialogID
+}
+
+// Update handles input for the player dialog.
+func (d *PlayerDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case closesDialog(msg, keymap.Current().Select):
+			return d, DialogActionClose{}
+		case key.Matches(msg, seasonProfileKey):
+			return d, DialogActionPlayerProfile{PlayerID: d.player.ID, Name: d.player.Name}
+		}
+	}
+	return d, nil
+}
+
+// View renders the player dialog.
+func (d *PlayerDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 64, 30)
+
+	content := d.renderContent(dialogWidth - 6)
+	return RenderDialogFrameWithHelp("Player", content, playerDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderContent renders the player header, match stats and events.
+func (d *PlayerDialog) renderContent(width int) string {
+	var lines []string
+
+	// Header: shirt number, name, team and position
+	name := d.player.Name
+	if d.player.Number > 0 {
+		name = fmt.Sprintf("#%d %s", d.player.Number, name)
+	}
+	lines = append(lines, dialogTeamStyle.Render(truncateName(name, width)))
+	info := d.teamName
+	if d.player.Position != "" {
+		info += " · " + d.player.Position
+	}
+	lines = append(lines, dialogDimStyle.Render(info))
+	lines = append(lines, dialogSeparatorStyle.Render(strings.Repeat("─", width)))
+
+	row := func(label, value string) string {
+		return dialogLabelStyle.Render(label) + dialogValueStyle.Render(value)
+	}
+
+	// Match statistics
+	lines = append(lines, dialogHeaderStyle.Render("Match Stats"))
+	if d.player.Rating != "" {
+		// Ratings are padded to 4 columns, so shorten the label to keep values aligned
+		lines = append(lines, dialogLabelStyle.Width(11).Render("Rating")+renderRating(d.player.Rating, true))
+	}
+	if perf := d.player.Performance; perf != nil {
+		lines = append(lines,
+			row("Minutes", fmt.Sprintf("%d'", perf.MinutesPlayed)),
+			row("Goals", fmt.Sprintf("%d", perf.Goals)),
+			row("Assists", fmt.Sprintf("%d", perf.Assists)),
+			row("Shots", fmt.Sprintf("%d", perf.Shots)),
+		)
+		if perf.Passes > 0 {
+			lines = append(lines, row("Passes", fmt.Sprintf("%d/%d (%d%%)", perf.AccuratePasses, perf.Passes, perf.AccuratePasses*100/perf.Passes)))
+		}
+		if perf.FantasyScore != "" {
+			lines = append(lines, row("Fantasy", perf.FantasyScore))
+		}
+	} else if d.player.Rating == "" {
+		lines = append(lines, dialogDimStyle.Render("Match stats not available"))
+	}
+	lines = append(lines, "")
+
+	// Match events involving the player
+	lines = append(lines, dialogHeaderStyle.Render("Events"))
+	events := d.playerEvents()
+	if len(events) == 0 {
+		lines = append(lines, dialogDimStyle.Render("No events"))
+	}
+	for _, e := range events {
+		lines = append(lines, dialogDimStyle.Width(6).Render(e.minute)+e.text)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// playerEvent is a match event described from the player's perspective.
+type playerEvent struct {
+	minute string
+	text   string
+}
+
+// playerEvents returns the match events involving the player, in chronological order.
+func (d *PlayerDialog) playerEvents() []playerEvent {
+	key := playerKey(d.player.ID, d.player.Name)
+	is := func(id int, name string) bool {
+		return playerKey(id, name) == key
+	}
+	nameOf := func(p *string) string {
+		if p == nil {
+			return ""
+		}
+		return *p
+	}
+
+	var result []playerEvent
+	for _, e := range d.events {
+		var text string
+		switch e.Kind {
+		case api.EventKindGoal:
+			switch {
+			case is(e.PlayerID, nameOf(e.Player)) && e.IsOwnGoal:
+				text = neonRedCardStyle.Render("Own goal")
+			case is(e.PlayerID, nameOf(e.Player)) && e.IsPenalty:
+				text = neonValueStyle.Render("Goal (penalty)")
+			case is(e.PlayerID, nameOf(e.Player)):
+				text = neonValueStyle.Render("Goal")
+			case e.Assist != nil && *e.Assist == d.player.Name:
+				text = dialogValueStyle.Render("Assist") + dialogDimStyle.Render(" for "+nameOf(e.Player))
+			}
+		case api.EventKindDisallowedGoal:
+			if is(e.PlayerID, nameOf(e.Player)) {
+				text = dialogDimStyle.Render("Goal disallowed")
+			}
+		case api.EventKindMissedPenalty:
+			if is(e.PlayerID, nameOf(e.Player)) {
+				text = neonRedCardStyle.Render("Missed penalty")
+			}
+		case api.EventKindCard:
+			if is(e.PlayerID, nameOf(e.Player)) {
+				switch e.Card {
+				case api.CardYellow:
+					text = neonYellowCardStyle.Render(CardSymbolYellow + " Yellow card")
+				case api.CardSecondYellow:
+					text = neonRedCardStyle.Render(CardSymbolRed + " Second yellow")
+				default:
+					text = neonRedCardStyle.Render(CardSymbolRed + " Red card")
+				}
+			}
+		case api.EventKindSubstitution:
+			if e.PlayerIn != nil && is(e.PlayerIn.ID, e.PlayerIn.Name) {
+				text = dialogHeaderStyle.Render("▲ Came on")
+				if e.PlayerOut != nil {
+					text += dialogDimStyle.Render(" for " + e.PlayerOut.Name)
+				}
+			} else if e.PlayerOut != nil && is(e.PlayerOut.ID, e.PlayerOut.Name) {
+				text = neonRedCardStyle.Render("▼ Subbed off")
+				if e.PlayerIn != nil {
+					text += dialogDimStyle.Render(" for " + e.PlayerIn.Name)
+				}
+			}
+		}
+		if text != "" {
+			result = append(result, playerEvent{minute: eventMinute(e), text: text})
+		}
+	}
+	return result
+}
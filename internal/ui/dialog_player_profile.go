@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const playerProfileDialogID = "player_profile"
+
+// PlayerProfileDialog displays a player's profile and current season statistics.
+type PlayerProfileDialog struct {
+	name    string // Requested player name, shown when the profile is unavailable
+	profile *api.PlayerProfile
+}
+
+// NewPlayerProfileDialog creates a new player profile dialog.
+// profile may be nil if the lookup failed.
+func NewPlayerProfileDialog(name string, profile *api.PlayerProfile) *PlayerProfileDialog {
+	return &PlayerProfileDialog{
+		name:    name,
+		profile: profile,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *PlayerProfileDialog) ID() string {
+	return playerProfileDialogID
+}
+
+// Update handles input for the player profile dialog.
+func (d *PlayerProfileDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	if msg, ok := msg.(tea.KeyMsg); ok && closesDialog(msg, keymap.Current().Select) {
+		return d, DialogActionClose{}
+	}
+	return d, nil
+}
+
+// View renders the player profile.
+func (d *PlayerProfileDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 64, 26)
+
+	var content string
+	if d.profile == nil {
+		content = dialogDimStyle.Render(fmt.Sprintf("Profile not available for %s", d.name))
+	} else {
+		content = RenderPlayerProfile(d.profile, dialogWidth-6)
+	}
+	return RenderDialogFrameWithHelp("Player Profile", content, keymap.HelpLine(closeHelp()), dialogWidth, dialogHeight)
+}
+
+// RenderPlayerProfile renders a player's profile and season statistics.
+// Shared by the profile dialog and the `golazo player` command.
+func RenderPlayerProfile(profile *api.PlayerProfile, width int) string {
+	var lines []string
+
+	name := profile.Name
+	if profile.ShirtNumber > 0 {
+		name = fmt.Sprintf("#%d %s", profile.ShirtNumber, name)
+	}
+	lines = append(lines, dialogTeamStyle.Render(truncateName(name, width)))
+
+	var info []string
+	for _, s := range []string{profile.Team.Name, profile.Position, profile.Country} {
+		if s != "" {
+			info = append(info, s)
+		}
+	}
+	if profile.Age > 0 {
+		info = append(info, fmt.Sprintf("%d yrs", profile.Age))
+	}
+	if len(info) > 0 {
+		lines = append(lines, dialogDimStyle.Render(strings.Join(info, " · ")))
+	}
+	lines = append(lines, dialogSeparatorStyle.Render(strings.Repeat("─", width)))
+
+	row := func(label, value string) string {
+		return dialogLabelStyle.Render(label) + dialogValueStyle.Render(value)
+	}
+
+	season := profile.Season
+	header := "Season"
+	if season.Season != "" {
+		header += " " + season.Season
+	}
+	if season.League != "" {
+		header += " · " + season.League
+	}
+	lines = append(lines, dialogHeaderStyle.Render(header))
+	lines = append(lines,
+		row("Apps", fmt.Sprintf("%d", season.Appearances)),
+		row("Goals", fmt.Sprintf("%d", season.Goals)),
+		row("Assists", fmt.Sprintf("%d", season.Assists)),
+	)
+	if season.Rating != "" {
+		lines = append(lines, dialogLabelStyle.Render("Rating")+renderRating(season.Rating, true))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
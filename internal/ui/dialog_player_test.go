@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestPlayerEvents(t *testing.T) {
+	name := func(s string) *string { return &s }
+	saka := &api.EventPlayer{ID: 7, Name: "Bukayo Saka"}
+	martinelli := &api.EventPlayer{ID: 11, Name: "Gabriel Martinelli"}
+	events := []api.MatchEvent{
+		{Minute: 12, Kind: api.EventKindGoal, Player: name("Bukayo Saka"), PlayerID: 7},
+		{Minute: 30, Kind: api.EventKindGoal, Player: name("Kai Havertz"), PlayerID: 29, Assist: name("Bukayo Saka")},
+		{Minute: 41, Kind: api.EventKindCard, Player: name("Bukayo Saka"), PlayerID: 7, Card: api.CardYellow},
+		{Minute: 55, Kind: api.EventKindGoal, Player: name("Bukayo Saka"), PlayerID: 7, IsPenalty: true},
+		{Minute: 60, Kind: api.EventKindDisallowedGoal, Player: name("Bukayo Saka"), PlayerID: 7},
+		{Minute: 70, Kind: api.EventKindGoal, Player: name("Bukayo Saka"), PlayerID: 7, IsOwnGoal: true},
+		{Minute: 75, Kind: api.EventKindCard, Player: name("Declan Rice"), Card: api.CardSecondYellow},
+		{Minute: 80, DisplayMinute: "80+1'", Kind: api.EventKindSubstitution, PlayerIn: martinelli, PlayerOut: saka},
+	}
+
+	tests := []struct {
+		player api.PlayerInfo
+		want   []string // minute and text of each event
+		desc   string
+	}{
+		{
+			player: api.PlayerInfo{ID: 7, Name: "Bukayo Saka"},
+			want: []string{
+				"12' Goal",
+				"30' Assist for Kai Havertz",
+				"41' " + CardSymbolYellow + " Yellow card",
+				"55' Goal (penalty)",
+				"60' Goal disallowed",
+				"70' Own goal",
+				"80+1' ▼ Subbed off for Gabriel Martinelli",
+			},
+			desc: "events of the player, in order",
+		},
+		{
+			player: api.PlayerInfo{ID: 11, Name: "Gabriel Martinelli"},
+			want:   []string{"80+1' ▲ Came on for Bukayo Saka"},
+			desc:   "substitute coming on",
+		},
+		{
+			player: api.PlayerInfo{Name: "Declan Rice"},
+			want:   []string{"75' " + CardSymbolRed + " Second yellow"},
+			desc:   "player without an ID matched by name",
+		},
+		{
+			player: api.PlayerInfo{ID: 1, Name: "David Raya"},
+			desc:   "player without events",
+		},
+	}
+
+	for _, tt := range tests {
+		d := NewPlayerDialog(tt.player, "Arsenal", events)
+		var got []string
+		for _, e := range d.playerEvents() {
+			got = append(got, e.minute+" "+e.text)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("playerEvents() = %q; want %q - %s", got, tt.want, tt.desc)
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const shotMapDialogID = "shotmap"
+
+const (
+	shotMapPitchWidth  = 61 // Columns used for the half-pitch (across the pitch)
+	shotMapPitchHeight = 23 // Rows used for the half-pitch (goal line to halfway line)
+)
+
+// Pitch markings in metres.
+const (
+	penaltyAreaDepth = 16.5
+	penaltyAreaWidth = 40.32
+	sixYardDepth     = 5.5
+	sixYardWidth     = 18.32
+	goalWidth        = 7.32
+	penaltySpotDist  = 11.0
+	centreCircleR    = 9.15
+)
+
+// ShotMapDialog plots each team's shots on an ASCII half-pitch,
+// coloured by outcome and sized by xG.
+type ShotMapDialog struct {
+	homeTeam    string
+	awayTeam    string
+	shots       []api.Shot
+	focusedTeam int // 0 = home, 1 = away
+}
+
+// NewShotMapDialog creates a new shot map dialog.
+func NewShotMapDialog(homeTeam, awayTeam string, shots []api.Shot) *ShotMapDialog {
+	return &ShotMapDialog{
+		homeTeam:    homeTeam,
+		awayTeam:    awayTeam,
+		shots:       shots,
+		focusedTeam: 0,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *ShotMapDialog) ID() string {
+	return shotMapDialogID
+}
+
+// Update handles input for the shot map dialog.
+func (d *ShotMapDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.ShotMap):
+			return d, DialogActionClose{}
+		case key.Matches(msg, dialogTabKey, km.Left, km.Right):
+			// Toggle between home and away
+			d.focusedTeam = 1 - d.focusedTeam
+		}
+	}
+	return d, nil
+}
+
+// View renders the shot map.
+func (d *ShotMapDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+
+	content := d.renderContent(dialogWidth - 6)
+	return RenderDialogFrameWithHelp("Shot Map", content, shotMapDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderContent renders the pitch with the focused team's shots and a summary panel.
+func (d *ShotMapDialog) renderContent(width int) string {
+	teamName := d.homeTeam
+	if d.focusedTeam == 1 {
+		teamName = d.awayTeam
+	}
+
+	var shots []api.Shot
+	for _, s := range d.shots {
+		if s.IsHome == (d.focusedTeam == 0) {
+			shots = append(shots, s)
+		}
+	}
+
+	header := d.renderTeamTabs(width)
+	if len(d.shots) == 0 {
+		return lipgloss.JoinVertical(lipgloss.Left, header, "", dialogDimStyle.Render("Shot data not available"))
+	}
+
+	pitch := renderShotPitch(shots)
+	summaryWidth := width - shotMapPitchWidth - 3
+	if summaryWidth < 16 {
+		return lipgloss.JoinVertical(lipgloss.Left, header, "", pitch)
+	}
+	summary := renderShotSummary(teamName, shots, summaryWidth)
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top, pitch, "   ", summary)
+	return lipgloss.JoinVertical(lipgloss.Left, header, "", body)
+}
+
+// renderTeamTabs renders both team names, highlighting the focused one.
+func (d *ShotMapDialog) renderTeamTabs(width int) string {
+	home := dialogDimStyle.Render(d.homeTeam)
+	away := dialogDimStyle.Render(d.awayTeam)
+	if d.focusedTeam == 0 {
+		home = dialogTeamStyle.Render(d.homeTeam)
+	} else {
+		away = dialogTeamStyle.Render(d.awayTeam)
+	}
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Center).Render(home + dialogDimStyle.Render("  │  ") + away)
+}
+
+// renderShotPitch draws a half-pitch with the goal at the top and plots the shots on it.
+func renderShotPitch(shots []api.Shot) string {
+	grid := make([][]string, shotMapPitchHeight)
+	for r := range grid {
+		grid[r] = make([]string, shotMapPitchWidth)
+		for c := range grid[r] {
+			grid[r][c] = " "
+		}
+	}
+
+	lineStyle := dialogSeparatorStyle
+	set := func(row, col int, s string) {
+		if row >= 0 && row < shotMapPitchHeight && col >= 0 && col < shotMapPitchWidth {
+			grid[row][col] = s
+		}
+	}
+	rect := func(top, left, bottom, right int) {
+		for c := left; c <= right; c++ {
+			set(top, c, lineStyle.Render("─"))
+			set(bottom, c, lineStyle.Render("─"))
+		}
+		for r := top; r <= bottom; r++ {
+			set(r, left, lineStyle.Render("│"))
+			set(r, right, lineStyle.Render("│"))
+		}
+		set(top, left, lineStyle.Render("┌"))
+		set(top, right, lineStyle.Render("┐"))
+		set(bottom, left, lineStyle.Render("└"))
+		set(bottom, right, lineStyle.Render("┘"))
+	}
+
+	// Outline: goal line at the top, halfway line at the bottom
+	rect(0, 0, shotMapPitchHeight-1, shotMapPitchWidth-1)
+
+	// Penalty area and six-yard box, hanging from the goal line
+	for _, box := range []struct{ depth, width float64 }{{penaltyAreaDepth, penaltyAreaWidth}, {sixYardDepth, sixYardWidth}} {
+		left := pitchCol((api.PitchWidth - box.width) / 2)
+		right := pitchCol((api.PitchWidth + box.width) / 2)
+		rect(0, left, pitchRow(box.depth), right)
+		set(0, left, lineStyle.Render("┬"))
+		set(0, right, lineStyle.Render("┬"))
+	}
+
+	// Penalty arc and centre circle, sampled as dots inside the lines
+	boxRow := pitchRow(penaltyAreaDepth)
+	for deg := 0; deg < 360; deg += 4 {
+		rad := float64(deg) * math.Pi / 180
+		col := pitchCol(api.PitchWidth/2 + centreCircleR*math.Cos(rad))
+		if row := pitchRow(penaltySpotDist + centreCircleR*math.Sin(rad)); row > boxRow {
+			set(row, col, lineStyle.Render("·"))
+		}
+		if row := pitchRow(api.PitchLength/2 - centreCircleR*math.Abs(math.Sin(rad))); row < shotMapPitchHeight-1 {
+			set(row, col, lineStyle.Render("·"))
+		}
+	}
+	set(pitchRow(penaltySpotDist), pitchCol(api.PitchWidth/2), lineStyle.Render("·"))
+
+	// Goal mouth on the goal line
+	goalStyle := dialogValueStyle.Bold(true)
+	for c := pitchCol((api.PitchWidth - goalWidth) / 2); c <= pitchCol((api.PitchWidth+goalWidth)/2); c++ {
+		set(0, c, goalStyle.Render("━"))
+	}
+
+	// Plot shots, the most significant outcome wins when shots share a cell
+	priority := make(map[[2]int]int)
+	for _, s := range shots {
+		row := pitchRow(api.PitchLength - s.X)
+		col := pitchCol(s.Y)
+		key := [2]int{row, col}
+		p := shotOutcomePriority(s.Outcome)
+		if existing, ok := priority[key]; ok && existing >= p {
+			continue
+		}
+		priority[key] = p
+		marker := shotMarker(s.XG)
+		if accessible {
+			marker = shotOutcomeLetter(s.Outcome)
+		}
+		set(row, col, shotOutcomeStyle(s.Outcome).Render(marker))
+	}
+
+	lines := make([]string, shotMapPitchHeight)
+	for r := range grid {
+		lines[r] = strings.Join(grid[r], "")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderShotSummary renders shot totals and the legend for one team.
+func renderShotSummary(teamName string, shots []api.Shot, width int) string {
+	var goals, onTarget, blocked int
+	var xg float64
+	for _, s := range shots {
+		switch s.Outcome {
+		case api.ShotGoal:
+			goals++
+			onTarget++
+		case api.ShotOnTarget:
+			onTarget++
+		case api.ShotBlocked:
+			blocked++
+		}
+		if !s.IsOwnGoal {
+			xg += s.XG
+		}
+	}
+
+	row := func(label, value string) string {
+		return dialogLabelStyle.Render(label) + dialogValueStyle.Render(value)
+	}
+
+	lines := []string{
+		dialogTeamStyle.Render(truncateName(teamName, width)),
+		"",
+		row("Shots", fmt.Sprintf("%d", len(shots))),
+		row("On target", fmt.Sprintf("%d", onTarget)),
+		row("Blocked", fmt.Sprintf("%d", blocked)),
+		row("Goals", fmt.Sprintf("%d", goals)),
+		row("xG", fmt.Sprintf("%.2f", xg)),
+		"",
+		dialogHeaderStyle.Render("Outcome"),
+	}
+	for _, outcome := range []api.ShotOutcome{api.ShotGoal, api.ShotOnTarget, api.ShotBlocked, api.ShotOff} {
+		marker := "●"
+		if accessible {
+			marker = shotOutcomeLetter(outcome)
+		}
+		lines = append(lines, shotOutcomeStyle(outcome).Render(marker)+dialogDimStyle.Render(" "+shotOutcomeName(outcome)))
+	}
+
+	// Accessible mode marks outcomes with letters, so marker sizes don't show xG
+	if !accessible {
+		lines = append(lines,
+			"",
+			dialogHeaderStyle.Render("xG"),
+			dialogValueStyle.Render(shotMarker(0.05))+dialogDimStyle.Render(" < 0.10"),
+			dialogValueStyle.Render(shotMarker(0.2))+dialogDimStyle.Render(" < 0.30"),
+			dialogValueStyle.Render(shotMarker(0.4))+dialogDimStyle.Render(" < 0.60"),
+			dialogValueStyle.Render(shotMarker(0.8))+dialogDimStyle.Render(" ≥ 0.60"),
+		)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// shotMarker returns a marker sized by the shot's xG.
+func shotMarker(xg float64) string {
+	switch {
+	case xg < 0.1:
+		return "·"
+	case xg < 0.3:
+		return "•"
+	case xg < 0.6:
+		return "●"
+	default:
+		return "⬤"
+	}
+}
+
+// shotOutcomeName names a shot outcome in the legend.
+func shotOutcomeName(outcome api.ShotOutcome) string {
+	switch outcome {
+	case api.ShotGoal:
+		return "Goal"
+	case api.ShotOnTarget:
+		return "On target"
+	case api.ShotBlocked:
+		return "Blocked"
+	default:
+		return "Off target"
+	}
+}
+
+// shotOutcomeLetter marks a shot by outcome rather than colour, for accessible mode.
+func shotOutcomeLetter(outcome api.ShotOutcome) string {
+	switch outcome {
+	case api.ShotGoal:
+		return "G"
+	case api.ShotOnTarget:
+		return "T"
+	case api.ShotBlocked:
+		return "B"
+	default:
+		return "x"
+	}
+}
+
+// shotOutcomeStyle returns the colour used for a shot outcome.
+func shotOutcomeStyle(outcome api.ShotOutcome) lipgloss.Style {
+	switch outcome {
+	case api.ShotGoal:
+		return lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+	case api.ShotOnTarget:
+		return lipgloss.NewStyle().Foreground(neonCyan)
+	case api.ShotBlocked:
+		return lipgloss.NewStyle().Foreground(neonYellow)
+	default:
+		return lipgloss.NewStyle().Foreground(neonGray)
+	}
+}
+
+// shotOutcomePriority ranks outcomes so goals are never hidden behind other shots.
+func shotOutcomePriority(outcome api.ShotOutcome) int {
+	switch outcome {
+	case api.ShotGoal:
+		return 3
+	case api.ShotOnTarget:
+		return 2
+	case api.ShotBlocked:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// pitchRow maps a distance from the goal line (metres) to a pitch row.
+func pitchRow(dist float64) int {
+	row := int(math.Round(dist / (api.PitchLength / 2) * float64(shotMapPitchHeight-1)))
+	return clampInt(row, 0, shotMapPitchHeight-1)
+}
+
+// pitchCol maps a position across the pitch (metres) to a pitch column.
+func pitchCol(y float64) int {
+	col := int(math.Round(y / api.PitchWidth * float64(shotMapPitchWidth-1)))
+	return clampInt(col, 0, shotMapPitchWidth-1)
+}
+
+// clampInt limits v to the range [lo, hi].
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
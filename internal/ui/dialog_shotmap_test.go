@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestRenderShotPitch(t *testing.T) {
+	penaltySpot := api.PitchLength - penaltySpotDist
+
+	tests := []struct {
+		shots []api.Shot
+		x, y  float64 // Cell checked
+		want  string
+		desc  string
+	}{
+		{[]api.Shot{{X: penaltySpot, Y: 34, XG: 0.79, Outcome: api.ShotGoal}}, penaltySpot, 34, "⬤", "high xG"},
+		{[]api.Shot{{X: 80, Y: 10, XG: 0.04, Outcome: api.ShotOff}}, 80, 10, "·", "low xG"},
+		{[]api.Shot{{X: 90, Y: 50, XG: 0.2, Outcome: api.ShotBlocked}}, 90, 50, "•", "medium xG"},
+		{[]api.Shot{
+			{X: 90, Y: 30, XG: 0.45, Outcome: api.ShotGoal},
+			{X: 90, Y: 30, XG: 0.05, Outcome: api.ShotOff},
+		}, 90, 30, "●", "goal kept when a later shot shares its cell"},
+		{[]api.Shot{{X: 120, Y: -5, XG: 0.2, Outcome: api.ShotOnTarget}}, api.PitchLength, 0, "•", "off the pitch, clamped to the corner"},
+	}
+
+	for _, tt := range tests {
+		lines := strings.Split(renderShotPitch(tt.shots), "\n")
+		if len(lines) != shotMapPitchHeight {
+			t.Fatalf("renderShotPitch() = %d rows; want %d - %s", len(lines), shotMapPitchHeight, tt.desc)
+		}
+		row := []rune(lines[pitchRow(api.PitchLength-tt.x)])
+		if len(row) != shotMapPitchWidth {
+			t.Fatalf("renderShotPitch() row = %d columns; want %d - %s", len(row), shotMapPitchWidth, tt.desc)
+		}
+		if got := string(row[pitchCol(tt.y)]); got != tt.want {
+			t.Errorf("renderShotPitch() cell = %q; want %q - %s", got, tt.want, tt.desc)
+		}
+	}
+}
@@ -5,31 +5,57 @@ import (
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
-	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 const standingsDialogID = "standings"
 
-// StandingsDialog displays the league standings table for a match.
+// standingsView selects which ranking of a table is shown.
+type standingsView int
+
+const (
+	standingsViewAll standingsView = iota
+	standingsViewHome
+	standingsViewAway
+)
+
+// standingsViewNames are the tab labels, indexed by standingsView.
+var standingsViewNames = []string{"All", "Home", "Away"}
+
+// StandingsDialog displays the league standings tables for a match.
+// Tab switches between overall, home and away rankings; ←/→ switches between groups.
 type StandingsDialog struct {
-	leagueName  string
-	standings   []api.LeagueTableEntry
-	homeTeamID  int
-	awayTeamID  int
-	scrollIndex int
+	leagueName string
+	groups     []api.LeagueTableGroup
+	group      int // Index of the group shown
+	view       standingsView
+	homeTeamID int
+	awayTeamID int
+	selected   int // Selected row, opens the team overview on Enter
 }
 
 // NewStandingsDialog creates a new standings dialog.
-func NewStandingsDialog(leagueName string, standings []api.LeagueTableEntry, homeTeamID, awayTeamID int) *StandingsDialog {
-	return &StandingsDialog{
-		leagueName:  leagueName,
-		standings:   standings,
-		homeTeamID:  homeTeamID,
-		awayTeamID:  awayTeamID,
-		scrollIndex: 0,
+// The group containing the home team is shown first.
+func NewStandingsDialog(leagueName string, groups []api.LeagueTableGroup, homeTeamID, awayTeamID int) *StandingsDialog {
+	d := &StandingsDialog{
+		leagueName: leagueName,
+		groups:     groups,
+		homeTeamID: homeTeamID,
+		awayTeamID: awayTeamID,
+		selected:   0,
+	}
+	for i, g := range groups {
+		for _, entry := range g.All {
+			if entry.Team.ID == homeTeamID {
+				d.group = i
+				return d
+			}
+		}
 	}
+	return d
 }
 
 // ID returns the dialog identifier.
@@ -41,40 +67,90 @@ func (d *StandingsDialog) ID() string {
 func (d *StandingsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc", "s", "q":
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Standings):
 			return d, DialogActionClose{}
-		case "j", "down":
-			if d.scrollIndex < len(d.standings)-1 {
-				d.scrollIndex++
+		case key.Matches(msg, km.Down):
+			if d.selected < len(d.entries())-1 {
+				d.selected++
+			}
+		case key.Matches(msg, km.Up):
+			if d.selected > 0 {
+				d.selected--
 			}
-		case "k", "up":
-			if d.scrollIndex > 0 {
-				d.scrollIndex--
+		case key.Matches(msg, dialogTabKey):
+			d.view = (d.view + 1) % standingsView(len(standingsViewNames))
+			d.clampSelection()
+		case key.Matches(msg, dialogShiftTabKey):
+			d.view = (d.view + standingsView(len(standingsViewNames)) - 1) % standingsView(len(standingsViewNames))
+			d.clampSelection()
+		case key.Matches(msg, km.Right):
+			if d.group < len(d.groups)-1 {
+				d.group++
+				d.clampSelection()
+			}
+		case key.Matches(msg, km.Left):
+			if d.group > 0 {
+				d.group--
+				d.clampSelection()
+			}
+		case key.Matches(msg, km.Select):
+			entries := d.entries()
+			if d.selected < len(entries) {
+				team := entries[d.selected].Team
+				return d, DialogActionTeamDetails{TeamID: team.ID, Name: team.Name}
 			}
 		}
 	}
 	return d, nil
 }
 
+// entries returns the rows of the current group and view.
+func (d *StandingsDialog) entries() []api.LeagueTableEntry {
+	if d.group >= len(d.groups) {
+		return nil
+	}
+	g := d.groups[d.group]
+	switch d.view {
+	case standingsViewHome:
+		return g.Home
+	case standingsViewAway:
+		return g.Away
+	default:
+		return g.All
+	}
+}
+
+// clampSelection keeps the selected row within the current table.
+func (d *StandingsDialog) clampSelection() {
+	d.selected = max(min(d.selected, len(d.entries())-1), 0)
+}
+
 // View renders the standings table.
 func (d *StandingsDialog) View(width, height int) string {
 	// Calculate dialog dimensions (larger for better readability)
-	dialogWidth, dialogHeight := DialogSize(width, height, 90, 32)
+	dialogWidth, dialogHeight := DialogSize(width, height, 90, 36)
 
 	// Build the table content
 	content := d.renderTable(dialogWidth - 6) // Account for padding and border
 
-	return RenderDialogFrameWithHelp(d.leagueName+" Standings", content, constants.HelpStandingsDialog, dialogWidth, dialogHeight)
+	return RenderDialogFrameWithHelp(d.leagueName+" Standings", content, standingsDialogHelp(), dialogWidth, dialogHeight)
 }
 
-// renderTable renders the standings table.
+// renderTable renders the view tabs, the standings table and the zone legend.
 func (d *StandingsDialog) renderTable(width int) string {
-	if len(d.standings) == 0 {
+	if len(d.groups) == 0 {
 		return dialogDimStyle.Render("No standings data available")
 	}
 
-	var lines []string
+	lines := []string{d.renderTabs(width), ""}
+
+	entries := d.entries()
+	if len(entries) == 0 {
+		lines = append(lines, dialogDimStyle.Render(standingsViewNames[d.view]+" table not available"))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
 
 	// Header row
 	header := d.renderHeaderRow(width)
@@ -85,27 +161,91 @@ func (d *StandingsDialog) renderTable(width int) string {
 	lines = append(lines, separator)
 
 	// Data rows
-	for _, entry := range d.standings {
-		row := d.renderTeamRow(entry, width)
+	for i, entry := range entries {
+		row := d.renderTeamRow(entry, width, i == d.selected)
 		lines = append(lines, row)
 	}
 
+	if legend := d.renderLegend(); legend != "" {
+		lines = append(lines, "", legend)
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderTabs renders the All/Home/Away tabs and, for multiple groups, the current group.
+func (d *StandingsDialog) renderTabs(width int) string {
+	var tabs []string
+	for i, name := range standingsViewNames {
+		if standingsView(i) == d.view {
+			tabs = append(tabs, dialogHighlightStyle.Render("["+name+"]"))
+		} else {
+			tabs = append(tabs, dialogDimStyle.Render(" "+name+" "))
+		}
+	}
+	left := strings.Join(tabs, " ")
+
+	if len(d.groups) < 2 {
+		return left
+	}
+
+	prev, next := " ", " "
+	if d.group > 0 {
+		prev = "◂"
+	}
+	if d.group < len(d.groups)-1 {
+		next = "▸"
+	}
+	right := dialogDimStyle.Render(prev+" ") +
+		dialogTeamStyle.Render(d.groups[d.group].Name) +
+		dialogDimStyle.Render(fmt.Sprintf("  %d/%d ", d.group+1, len(d.groups))+next)
+
+	gap := max(width-lipgloss.Width(left)-lipgloss.Width(right), 1)
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// renderLegend lists the qualification and relegation zones of the current group.
+func (d *StandingsDialog) renderLegend() string {
+	var parts []string
+	seen := make(map[string]bool)
+	for _, entry := range d.groups[d.group].All {
+		if entry.Zone == "" || seen[entry.Zone] {
+			continue
+		}
+		seen[entry.Zone] = true
+		parts = append(parts, zoneMarker(entry.ZoneColor)+" "+dialogDimStyle.Render(entry.Zone))
+	}
+	return strings.Join(parts, "   ")
+}
+
+// zoneMarker renders the coloured bar that marks a team's zone, or a space if it has none.
+func zoneMarker(color string) string {
+	if color == "" {
+		return " "
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render("▌")
+}
+
 // Column widths for consistent alignment
 const (
 	standingsColPos  = 4 // Position column
 	standingsColStat = 5 // Stat columns (P, W, D, L)
 	standingsColGD   = 5 // Goal difference (needs +/- sign)
 	standingsColPts  = 5 // Points column
+	standingsColForm = 7 // Form column (last five results)
 )
 
+// standingsTeamWidth returns the width left for the team name column.
+func standingsTeamWidth(width int) int {
+	return width - 1 - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - standingsColForm - 4
+}
+
 // renderHeaderRow renders the table header.
 func (d *StandingsDialog) renderHeaderRow(width int) string {
-	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 4
+	teamWidth := standingsTeamWidth(width)
 
 	return lipgloss.JoinHorizontal(lipgloss.Top,
+		" ",
 		dialogHeaderStyle.Width(standingsColPos).Align(lipgloss.Right).Render("#"),
 		"  ",
 		dialogHeaderStyle.Width(teamWidth).Align(lipgloss.Left).Render("Team"),
@@ -115,14 +255,16 @@ func (d *StandingsDialog) renderHeaderRow(width int) string {
 		dialogHeaderStyle.Width(standingsColStat).Align(lipgloss.Right).Render("L"),
 		dialogHeaderStyle.Width(standingsColGD).Align(lipgloss.Right).Render("GD"),
 		dialogHeaderStyle.Width(standingsColPts).Align(lipgloss.Right).Render("Pts"),
+		dialogHeaderStyle.Width(standingsColForm).Align(lipgloss.Right).Render("Form"),
 	)
 }
 
-// renderTeamRow renders a single team row.
-func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) string {
+// renderTeamRow renders a single team row with its zone marker and form.
+func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int, selected bool) string {
 	isHighlighted := entry.Team.ID == d.homeTeamID || entry.Team.ID == d.awayTeamID
 
-	teamWidth := width - standingsColPos - (standingsColStat * 4) - standingsColGD - standingsColPts - 4
+	teamWidth := standingsTeamWidth(width)
+	rowWidth := width - 1 - standingsColForm
 
 	// Truncate team name if needed
 	teamName := entry.Team.ShortName
@@ -136,10 +278,16 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 	// Format goal difference with sign
 	gdStr := formatGoalDifference(entry.GoalDifference)
 
+	// Selection marker between position and team name
+	gutter := "  "
+	if selected {
+		gutter = " ▸"
+	}
+
 	// Build row content with fixed widths
 	rowContent := lipgloss.JoinHorizontal(lipgloss.Top,
 		lipgloss.NewStyle().Width(standingsColPos).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Position)),
-		"  ",
+		gutter,
 		lipgloss.NewStyle().Width(teamWidth).Align(lipgloss.Left).Render(teamName),
 		lipgloss.NewStyle().Width(standingsColStat).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Played)),
 		lipgloss.NewStyle().Width(standingsColStat).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Won)),
@@ -149,18 +297,24 @@ func (d *StandingsDialog) renderTeamRow(entry api.LeagueTableEntry, width int) s
 		lipgloss.NewStyle().Width(standingsColPts).Align(lipgloss.Right).Render(fmt.Sprintf("%d", entry.Points)),
 	)
 
+	// Zone marker and form are coloured on their own, outside the row styling
+	form := strings.Repeat(" ", standingsColForm-len(entry.Form)) + renderForm(entry.Form)
+
 	// Apply row styling
 	if isHighlighted {
 		// Background highlight for match teams
-		return lipgloss.NewStyle().
+		return zoneMarker(entry.ZoneColor) + lipgloss.NewStyle().
 			Background(neonDark).
 			Foreground(neonCyan).
 			Bold(true).
-			Width(width).
-			Render(rowContent)
+			Width(rowWidth).
+			Render(rowContent) + form
 	}
 
-	return dialogValueStyle.Render(rowContent)
+	if selected {
+		return zoneMarker(entry.ZoneColor) + dialogHighlightStyle.Render(rowContent) + form
+	}
+	return zoneMarker(entry.ZoneColor) + dialogValueStyle.Render(rowContent) + form
 }
 
 // formatGoalDifference formats goal difference with +/- sign.
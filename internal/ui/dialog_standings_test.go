@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// standingsEntries returns table rows for the given team IDs, in order.
+func standingsEntries(ids ...int) []api.LeagueTableEntry {
+	entries := make([]api.LeagueTableEntry, 0, len(ids))
+	for i, id := range ids {
+		entries = append(entries, api.LeagueTableEntry{Position: i + 1, Team: api.Team{ID: id, Name: string(rune('A' + id))}})
+	}
+	return entries
+}
+
+func TestStandingsDialogUpdate(t *testing.T) {
+	groups := []api.LeagueTableGroup{
+		{Name: "Grp. A", All: standingsEntries(1, 2, 3, 4), Home: standingsEntries(2, 1, 4, 3)},
+		{Name: "Grp. B", All: standingsEntries(5, 6, 7), Home: standingsEntries(6, 5, 7), Away: standingsEntries(7, 6)},
+	}
+	keys := map[string]tea.KeyMsg{
+		"down":      {Type: tea.KeyDown},
+		"up":        {Type: tea.KeyUp},
+		"left":      {Type: tea.KeyLeft},
+		"right":     {Type: tea.KeyRight},
+		"tab":       {Type: tea.KeyTab},
+		"shift+tab": {Type: tea.KeyShiftTab},
+	}
+
+	tests := []struct {
+		homeTeamID   int
+		keys         []string
+		wantGroup    int
+		wantView     standingsView
+		wantSelected int
+		wantTeam     int // Team opened on Enter, 0 for none
+		desc         string
+	}{
+		{homeTeamID: 6, wantGroup: 1, wantTeam: 5, desc: "opens on the home team's group"},
+		{homeTeamID: 99, wantGroup: 0, wantTeam: 1, desc: "unknown home team opens the first group"},
+		{homeTeamID: 1, keys: []string{"down", "down", "up"}, wantSelected: 1, wantTeam: 2, desc: "moves the selection"},
+		{homeTeamID: 1, keys: []string{"up", "down", "down", "down", "down", "down"}, wantSelected: 3, wantTeam: 4, desc: "selection stays within the table"},
+		{homeTeamID: 1, keys: []string{"tab"}, wantView: standingsViewHome, wantTeam: 2, desc: "tab shows the home table"},
+		{homeTeamID: 1, keys: []string{"shift+tab"}, wantView: standingsViewAway, desc: "shift+tab wraps to the missing away table"},
+		{homeTeamID: 5, keys: []string{"down", "down", "tab", "tab"}, wantGroup: 1, wantView: standingsViewAway, wantSelected: 1, wantTeam: 6, desc: "selection clamped to a shorter table"},
+		{homeTeamID: 1, keys: []string{"down", "down", "down", "right"}, wantGroup: 1, wantSelected: 2, wantTeam: 7, desc: "selection clamped when switching groups"},
+		{homeTeamID: 5, keys: []string{"right", "left", "left"}, wantGroup: 0, wantTeam: 1, desc: "groups stay within range"},
+	}
+
+	for _, tt := range tests {
+		d := NewStandingsDialog("Champions League", groups, tt.homeTeamID, 0)
+		for _, k := range tt.keys {
+			d.Update(keys[k])
+		}
+		if d.group != tt.wantGroup || d.view != tt.wantView || d.selected != tt.wantSelected {
+			t.Errorf("group, view, selected = %d, %d, %d; want %d, %d, %d - %s", d.group, d.view, d.selected, tt.wantGroup, tt.wantView, tt.wantSelected, tt.desc)
+		}
+
+		_, action := d.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		got := 0
+		if details, ok := action.(DialogActionTeamDetails); ok {
+			got = details.TeamID
+		}
+		if got != tt.wantTeam {
+			t.Errorf("Enter opened team %d; want %d - %s", got, tt.wantTeam, tt.desc)
+		}
+	}
+}
+
+func TestZoneMarker(t *testing.T) {
+	if got := zoneMarker(""); got != " " {
+		t.Errorf("zoneMarker(\"\") = %q; want a space", got)
+	}
+	if got := zoneMarker("#2AD572"); got != "▌" {
+		t.Errorf("zoneMarker(\"#2AD572\") = %q; want the zone bar", got)
+	}
+}
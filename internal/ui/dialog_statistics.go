@@ -7,6 +7,8 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -42,10 +44,11 @@ func (d *StatisticsDialog) ID() string {
 func (d *StatisticsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc", "x", "q":
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg, km.Statistics):
 			return d, DialogActionClose{}
-		case "j", "down":
+		case key.Matches(msg, km.Down):
 			maxScroll := len(d.statistics) - d.maxVisible
 			if maxScroll < 0 {
 				maxScroll = 0
@@ -53,7 +56,7 @@ func (d *StatisticsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 			if d.scrollIndex < maxScroll {
 				d.scrollIndex++
 			}
-		case "k", "up":
+		case key.Matches(msg, km.Up):
 			if d.scrollIndex > 0 {
 				d.scrollIndex--
 			}
@@ -70,7 +73,7 @@ func (d *StatisticsDialog) View(width, height int) string {
 	// Build the content
 	content := d.renderContent(dialogWidth - 6) // Account for padding and border
 
-	return RenderDialogFrameWithHelp(constants.PanelMatchStatistics, content, constants.HelpStatisticsDialog, dialogWidth, dialogHeight)
+	return RenderDialogFrameWithHelp(constants.PanelMatchStatistics, content, statisticsDialogHelp(), dialogWidth, dialogHeight)
 }
 
 // renderContent renders the statistics content.
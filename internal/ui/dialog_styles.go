@@ -10,85 +10,133 @@ import (
 // Dialog-specific styles using existing adaptive colors from neon_styles.go.
 // All colors are adaptive and work on both light and dark terminal backgrounds.
 var (
+	// dialogBorderStyle applies padding without border for a cleaner look.
+	dialogBorderStyle lipgloss.Style
+
+	// dialogTitleBarStyle styles the title bar with inverted colors.
+	dialogTitleBarStyle lipgloss.Style
+
+	// dialogTitleStyle styles plain dialog titles (fallback).
+	dialogTitleStyle lipgloss.Style
+
+	// dialogContentStyle styles the main dialog content.
+	dialogContentStyle lipgloss.Style
+
+	// dialogDimStyle styles secondary/muted text.
+	dialogDimStyle lipgloss.Style
+
+	// dialogHeaderStyle styles column headers in tables.
+	dialogHeaderStyle lipgloss.Style
+
+	// dialogHighlightStyle highlights important rows (e.g., current teams).
+	dialogHighlightStyle lipgloss.Style
+
+	// dialogValueStyle styles numeric values.
+	dialogValueStyle lipgloss.Style
+
+	// dialogLabelStyle styles labels with fixed width.
+	dialogLabelStyle lipgloss.Style
+
+	// dialogTeamStyle styles team names.
+	dialogTeamStyle lipgloss.Style
+
+	// dialogPositionStyle styles position indicators.
+	dialogPositionStyle lipgloss.Style
+
+	// dialogSeparatorStyle styles horizontal separators.
+	dialogSeparatorStyle lipgloss.Style
+
+	// dialogHelpStyle styles help text at the bottom.
+	dialogHelpStyle lipgloss.Style
+
+	// dialogBadgeStyle provides subtle background for values.
+	dialogBadgeStyle lipgloss.Style
+
+	// dialogBadgeHighlightStyle provides highlighted background for winning values.
+	dialogBadgeHighlightStyle lipgloss.Style
+)
+
+// buildDialogStyles builds the dialog styles from the current palette.
+func buildDialogStyles() {
 	// dialogBorderStyle applies padding without border for a cleaner look.
 	dialogBorderStyle = lipgloss.NewStyle().
-				Padding(1, 2)
+		Padding(1, 2)
 
 	// dialogTitleBarStyle styles the title bar with inverted colors.
 	dialogTitleBarStyle = lipgloss.NewStyle().
-				Background(neonRed).
-				Foreground(neonWhite).
-				Bold(true).
-				Padding(0, 2).
-				MarginBottom(1)
+		Background(neonRed).
+		Foreground(neonWhite).
+		Bold(true).
+		Padding(0, 2).
+		MarginBottom(1)
 
 	// dialogTitleStyle styles plain dialog titles (fallback).
 	dialogTitleStyle = lipgloss.NewStyle().
-				Foreground(neonRed).
-				Bold(true).
-				MarginBottom(1)
+		Foreground(neonRed).
+		Bold(true).
+		MarginBottom(1)
 
 	// dialogContentStyle styles the main dialog content.
 	dialogContentStyle = lipgloss.NewStyle().
-				Foreground(neonWhite)
+		Foreground(neonWhite)
 
 	// dialogDimStyle styles secondary/muted text.
 	dialogDimStyle = lipgloss.NewStyle().
-			Foreground(neonDim)
+		Foreground(neonDim)
 
 	// dialogHeaderStyle styles column headers in tables.
 	dialogHeaderStyle = lipgloss.NewStyle().
-				Foreground(neonCyan).
-				Bold(true)
+		Foreground(neonCyan).
+		Bold(true)
 
 	// dialogHighlightStyle highlights important rows (e.g., current teams).
 	dialogHighlightStyle = lipgloss.NewStyle().
-				Foreground(neonRed).
-				Bold(true)
+		Foreground(neonRed).
+		Bold(true)
 
 	// dialogValueStyle styles numeric values.
 	dialogValueStyle = lipgloss.NewStyle().
-				Foreground(neonWhiteAlt)
+		Foreground(neonWhiteAlt)
 
 	// dialogLabelStyle styles labels with fixed width.
 	dialogLabelStyle = lipgloss.NewStyle().
-				Foreground(neonDim).
-				Width(12)
+		Foreground(neonDim).
+		Width(12)
 
 	// dialogTeamStyle styles team names.
 	dialogTeamStyle = lipgloss.NewStyle().
-			Foreground(neonCyan).
-			Bold(true)
+		Foreground(neonCyan).
+		Bold(true)
 
 	// dialogPositionStyle styles position indicators.
 	dialogPositionStyle = lipgloss.NewStyle().
-				Foreground(neonWhite).
-				Width(3).
-				Align(lipgloss.Right)
+		Foreground(neonWhite).
+		Width(3).
+		Align(lipgloss.Right)
 
 	// dialogSeparatorStyle styles horizontal separators.
 	dialogSeparatorStyle = lipgloss.NewStyle().
-				Foreground(neonDarkDim)
+		Foreground(neonDarkDim)
 
 	// dialogHelpStyle styles help text at the bottom.
 	dialogHelpStyle = lipgloss.NewStyle().
-			Foreground(neonDim).
-			Italic(true).
-			MarginTop(1)
+		Foreground(neonDim).
+		Italic(true).
+		MarginTop(1)
 
 	// dialogBadgeStyle provides subtle background for values.
 	dialogBadgeStyle = lipgloss.NewStyle().
-				Background(neonDark).
-				Foreground(neonWhite).
-				Padding(0, 1)
+		Background(neonDark).
+		Foreground(neonWhite).
+		Padding(0, 1)
 
 	// dialogBadgeHighlightStyle provides highlighted background for winning values.
 	dialogBadgeHighlightStyle = lipgloss.NewStyle().
-					Background(neonRed).
-					Foreground(neonWhite).
-					Bold(true).
-					Padding(0, 1)
-)
+		Background(neonRed).
+		Foreground(neonWhite).
+		Bold(true).
+		Padding(0, 1)
+}
 
 // RenderDialogTitleBar creates a full-width title bar with background.
 func RenderDialogTitleBar(title string, width int) string {
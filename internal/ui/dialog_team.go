@@ -0,0 +1,266 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const teamDialogID = "team"
+
+const (
+	teamDialogMatches   = 5  // Results and fixtures shown per section
+	teamDialogSquadRows = 18 // Squad rows visible at once (plus position headers)
+)
+
+// TeamDialog displays a team overview: league position, form, results, fixtures and squad.
+// The squad list is navigable and selecting a player requests their season profile;
+// the team can be starred as a favourite of the active profile.
+type TeamDialog struct {
+	name        string // Requested team name, shown when details are unavailable
+	details     *api.TeamDetails
+	selected    int // Selected squad member
+	scrollIndex int
+}
+
+// NewTeamDialog creates a new team dialog.
+// details may be nil if the lookup failed.
+func NewTeamDialog(name string, details *api.TeamDetails) *TeamDialog {
+	return &TeamDialog{
+		name:    name,
+		details: details,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *TeamDialog) ID() string {
+	return teamDialogID
+}
+
+// Update handles input for the team dialog.
+func (d *TeamDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		km := keymap.Current()
+		switch {
+		case closesDialog(msg):
+			return d, DialogActionClose{}
+		case key.Matches(msg, km.Down):
+			if d.details != nil && d.selected < len(d.details.Squad)-1 {
+				d.selected++
+			}
+			if d.selected >= d.scrollIndex+teamDialogSquadRows {
+				d.scrollIndex = d.selected - teamDialogSquadRows + 1
+			}
+		case key.Matches(msg, km.Up):
+			if d.selected > 0 {
+				d.selected--
+			}
+			if d.selected < d.scrollIndex {
+				d.scrollIndex = d.selected
+			}
+		case key.Matches(msg, favouriteKey):
+			if d.details != nil && d.details.Team.ID != 0 {
+				return d, DialogActionToggleFavorite{TeamID: d.details.Team.ID, Name: d.details.Team.Name}
+			}
+		case key.Matches(msg, km.Select):
+			if d.details != nil && d.selected < len(d.details.Squad) {
+				member := d.details.Squad[d.selected]
+				if member.Position != "Coach" {
+					return d, DialogActionPlayerProfile{PlayerID: member.ID, Name: member.Name}
+				}
+			}
+		}
+	}
+	return d, nil
+}
+
+// View renders the team overview.
+func (d *TeamDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 97, 36)
+
+	title := d.name
+	var content string
+	if d.details == nil {
+		content = dialogDimStyle.Render(fmt.Sprintf("Team details not available for %s", d.name))
+	} else {
+		title = d.details.Team.Name
+		if data.IsFavoriteTeam(d.details.Team.ID) {
+			title = favoriteMarker + title
+		}
+		content = d.renderContent(dialogWidth - 6)
+	}
+	return RenderDialogFrameWithHelp(title, content, teamDialogHelp(), dialogWidth, dialogHeight)
+}
+
+// renderContent renders the summary line, then matches and squad side by side.
+func (d *TeamDialog) renderContent(width int) string {
+	leftWidth := (width - 3) * 55 / 100
+	rightWidth := width - 3 - leftWidth
+
+	left := lipgloss.JoinVertical(lipgloss.Left,
+		dialogHeaderStyle.Render("Recent Results"),
+		d.renderMatches(d.details.Results, leftWidth, true),
+		"",
+		dialogHeaderStyle.Render("Upcoming Fixtures"),
+		d.renderMatches(d.details.Fixtures, leftWidth, false),
+	)
+	right := d.renderSquad(rightWidth)
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(leftWidth).Render(left),
+		dialogSeparatorStyle.Render(" │ "),
+		right,
+	)
+
+	return lipgloss.JoinVertical(lipgloss.Left, d.renderSummary(width), "", body)
+}
+
+// renderSummary renders league position and form.
+func (d *TeamDialog) renderSummary(width int) string {
+	var parts []string
+	if d.details.League != "" {
+		league := d.details.League
+		if d.details.LeaguePosition > 0 {
+			league += " · " + ordinal(d.details.LeaguePosition)
+		}
+		parts = append(parts, dialogValueStyle.Render(league))
+	}
+	if d.details.Form != "" {
+		parts = append(parts, dialogDimStyle.Render("Form ")+renderForm(d.details.Form))
+	}
+	if len(parts) == 0 {
+		return dialogDimStyle.Render("No league information")
+	}
+	return lipgloss.NewStyle().Width(width).Render(strings.Join(parts, dialogDimStyle.Render("   │   ")))
+}
+
+// renderMatches renders up to five results or fixtures from the team's perspective.
+func (d *TeamDialog) renderMatches(matches []api.Match, width int, results bool) string {
+	if len(matches) == 0 {
+		if results {
+			return dialogDimStyle.Render("No recent results")
+		}
+		return dialogDimStyle.Render("No upcoming fixtures")
+	}
+
+	var lines []string
+	for _, m := range matches[:min(len(matches), teamDialogMatches)] {
+		isHome := m.HomeTeam.ID == d.details.Team.ID
+		opponent := m.AwayTeam.Name
+		venue := "H"
+		if !isHome {
+			opponent = m.HomeTeam.Name
+			venue = "A"
+		}
+
+		date := "      "
+		if m.MatchTime != nil {
+			date = m.MatchTime.Local().Format("Jan 02")
+		}
+
+		var outcome, detail string
+		if results && m.HomeScore != nil && m.AwayScore != nil {
+			goalsFor, goalsAgainst := *m.HomeScore, *m.AwayScore
+			if !isHome {
+				goalsFor, goalsAgainst = goalsAgainst, goalsFor
+			}
+			result := "D"
+			if goalsFor > goalsAgainst {
+				result = "W"
+			} else if goalsFor < goalsAgainst {
+				result = "L"
+			}
+			outcome = renderForm(result)
+			detail = fmt.Sprintf("%d-%d", goalsFor, goalsAgainst)
+		} else {
+			outcome = " "
+			if m.MatchTime != nil {
+				detail = m.MatchTime.Local().Format("15:04")
+			}
+		}
+
+		nameWidth := max(width-6-2-2-2-1-6, 4)
+		lines = append(lines, dialogDimStyle.Render(date)+"  "+outcome+"  "+
+			dialogDimStyle.Render(venue)+" "+
+			dialogValueStyle.Width(nameWidth).Render(truncateName(opponent, nameWidth))+
+			dialogValueStyle.Width(6).Align(lipgloss.Right).Render(detail))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderSquad renders the visible part of the squad, grouped by position.
+func (d *TeamDialog) renderSquad(width int) string {
+	lines := []string{dialogHeaderStyle.Render("Squad")}
+	squad := d.details.Squad
+	if len(squad) == 0 {
+		lines = append(lines, dialogDimStyle.Render("Squad not available"))
+		return lipgloss.JoinVertical(lipgloss.Left, lines...)
+	}
+
+	endIdx := min(d.scrollIndex+teamDialogSquadRows, len(squad))
+	for i := d.scrollIndex; i < endIdx; i++ {
+		m := squad[i]
+
+		// Position header at the start of each group
+		if i == 0 || squad[i-1].Position != m.Position {
+			lines = append(lines, dialogDimStyle.Render(m.Position))
+		}
+
+		number := "  "
+		if m.Number > 0 {
+			number = fmt.Sprintf("%2d", m.Number)
+		}
+		nameStyle := dialogContentStyle
+		prefix := " "
+		if i == d.selected {
+			nameStyle = dialogHighlightStyle
+			prefix = dialogHighlightStyle.Render("▸")
+		}
+		nameWidth := max(width-5, 4)
+		lines = append(lines, prefix+dialogDimStyle.Render(number)+"  "+nameStyle.Render(truncateName(m.Name, nameWidth)))
+	}
+
+	if len(squad) > teamDialogSquadRows {
+		lines = append(lines, dialogDimStyle.Render(fmt.Sprintf("(%d-%d of %d)", d.scrollIndex+1, endIdx, len(squad))))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderForm renders a form string (e.g., "WWDLW") with coloured results.
+func renderForm(form string) string {
+	var b strings.Builder
+	for _, r := range form {
+		switch r {
+		case 'W':
+			b.WriteString(lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render("W"))
+		case 'L':
+			b.WriteString(lipgloss.NewStyle().Foreground(neonRed).Bold(true).Render("L"))
+		default:
+			b.WriteString(dialogValueStyle.Render(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// ordinal formats a position as 1st, 2nd, 3rd, 4th, ...
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
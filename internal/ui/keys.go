@@ -0,0 +1,234 @@
+package ui
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"github.com/0xjuanma/golazo/internal/keymap"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Help bars are generated from the active key map, so they follow presets and overrides.
+
+// mainMenuHelp returns the main menu help bar.
+func mainMenuHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Pair(km.Up, km.Down, "navigate"),
+		km.Select,
+		km.Help,
+		km.Quit,
+	)
+}
+
+// settingsHelp returns the settings view help bar.
+func settingsHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Pair(km.Up, km.Down, "navigate"),
+		keymap.Pair(km.Left, km.Right, "switch tabs"),
+		km.Toggle,
+		km.Profile,
+		km.Theme,
+		keymap.Describe(km.Filter, "search"),
+		keymap.Describe(km.Select, "save"),
+		km.Back,
+	)
+}
+
+// liveGridHelp returns the help bar of the live view's grid mode.
+func liveGridHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Pair(km.Left, km.Right, "move"),
+		keymap.Describe(km.Select, "open match"),
+		keymap.Describe(km.Refresh, "refresh"),
+		keymap.Describe(km.Grid, "close grid"),
+		km.Back,
+	)
+}
+
+// statsDetailsHelp returns the help shown under the stats view details panel.
+func statsDetailsHelp(focused bool) string {
+	km := keymap.Current()
+	if !focused {
+		return keymap.HelpLine(km.FocusDetails)
+	}
+	return keymap.HelpLine(
+		keymap.Describe(km.FocusDetails, "unfocus"),
+		km.Standings,
+		km.LeagueLeaders,
+		km.Bracket,
+		km.Formations,
+		km.HeadToHead,
+		km.Statistics,
+		km.Momentum,
+		km.ShotMap,
+		km.Players,
+		keymap.Pair(km.HomeTeam, km.AwayTeam, "home/away team"),
+		keymap.Pair(km.Up, km.Down, "scroll"),
+	)
+}
+
+// Dialogs move with the key map's navigation keys, select with Select, and close
+// with Back, Quit or the key that opened them. Their own keys are defined here.
+var (
+	dialogTabKey      = key.NewBinding(key.WithKeys("tab"), key.WithHelp("Tab", "next"))
+	dialogShiftTabKey = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+Tab", "previous"))
+	pitchViewKey      = key.NewBinding(key.WithKeys("v"), key.WithHelp("v", "pitch/list"))
+	favouriteKey      = key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "favourite"))
+	seasonProfileKey  = key.NewBinding(key.WithKeys("p"), key.WithHelp("p", "season profile"))
+)
+
+// closesDialog reports whether msg closes a dialog: Back, Quit, or one of the
+// keys that opened it.
+func closesDialog(msg tea.KeyMsg, openers ...key.Binding) bool {
+	km := keymap.Current()
+	return key.Matches(msg, km.Back, km.Quit) || key.Matches(msg, openers...)
+}
+
+// closeHelp is the help entry for closing a dialog.
+func closeHelp() key.Binding {
+	return keymap.Describe(keymap.Current().Back, "close")
+}
+
+// withTab returns b with Tab added in front, for dialogs where Tab also switches.
+func withTab(b key.Binding, desc string) key.Binding {
+	return key.NewBinding(
+		key.WithKeys(append([]string{"tab"}, b.Keys()...)...),
+		key.WithHelp("Tab/"+b.Help().Key, desc),
+	)
+}
+
+// withoutTyping returns b without the keys that type text, plus extra keys, for
+// dialogs with a text input.
+func withoutTyping(b key.Binding, extra ...string) key.Binding {
+	keys := slices.DeleteFunc(slices.Clone(b.Keys()), func(k string) bool {
+		return utf8.RuneCountInString(k) == 1
+	})
+	for _, k := range extra {
+		if !slices.Contains(keys, k) {
+			keys = append(keys, k)
+		}
+	}
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(keymap.KeyName(keys[0]), b.Help().Desc))
+}
+
+// scrollDialogHelp returns the help bar of dialogs that only scroll.
+func scrollDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(keymap.Pair(km.Up, km.Down, "scroll"), closeHelp())
+}
+
+// standingsDialogHelp returns the standings dialog help bar.
+func standingsDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Describe(dialogTabKey, "all/home/away"),
+		keymap.Pair(km.Left, km.Right, "group"),
+		keymap.Pair(km.Up, km.Down, "navigate"),
+		keymap.Describe(km.Select, "team"),
+		closeHelp(),
+	)
+}
+
+// formationsDialogHelp returns the formations dialog help bar.
+func formationsDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		withTab(keymap.Pair(km.Left, km.Right, ""), "switch team"),
+		keymap.Pair(km.Up, km.Down, "select"),
+		keymap.Describe(km.Select, "player"),
+		pitchViewKey,
+		closeHelp(),
+	)
+}
+
+// statisticsDialogHelp returns the statistics dialog help bar.
+func statisticsDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(keymap.Pair(km.Up, km.Down, "navigate"), closeHelp())
+}
+
+// shotMapDialogHelp returns the shot map dialog help bar.
+func shotMapDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(withTab(keymap.Pair(km.Left, km.Right, ""), "switch team"), closeHelp())
+}
+
+// playerDialogHelp returns the player dialog help bar.
+func playerDialogHelp() string {
+	return keymap.HelpLine(seasonProfileKey, closeHelp())
+}
+
+// matchPlayersDialogHelp returns the match players dialog help bar.
+func matchPlayersDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Pair(km.Up, km.Down, "navigate"),
+		keymap.Describe(km.Select, "season profile"),
+		closeHelp(),
+	)
+}
+
+// teamDialogHelp returns the team dialog help bar.
+func teamDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Pair(km.Up, km.Down, "squad"),
+		keymap.Describe(km.Select, "player profile"),
+		favouriteKey,
+		closeHelp(),
+	)
+}
+
+// bracketDialogHelp returns the knockout bracket dialog help bar.
+func bracketDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		keymap.Pair(km.Left, km.Right, "rounds"),
+		keymap.Pair(km.Up, km.Down, "scroll"),
+		closeHelp(),
+	)
+}
+
+// leagueStatsDialogHelp returns the league leaders dialog help bar.
+func leagueStatsDialogHelp() string {
+	km := keymap.Current()
+	return keymap.HelpLine(
+		withTab(keymap.Pair(km.Left, km.Right, ""), "switch stat"),
+		keymap.Pair(km.Up, km.Down, "navigate"),
+		keymap.Describe(km.Select, "player profile"),
+		closeHelp(),
+	)
+}
+
+// paletteKeys returns the command palette's keys: those of the key map that do not
+// type into the query, and the palette's usual ones.
+func paletteKeys() (up, down, sel, back key.Binding) {
+	km := keymap.Current()
+	return withoutTyping(km.Up, "up", "ctrl+p", "ctrl+k"),
+		withoutTyping(km.Down, "down", "ctrl+n", "ctrl+j", "tab"),
+		withoutTyping(km.Select, "enter"),
+		withoutTyping(km.Back, "esc")
+}
+
+// paletteDialogHelp returns the command palette help bar.
+func paletteDialogHelp() string {
+	up, down, sel, back := paletteKeys()
+	return keymap.HelpLine(keymap.Pair(up, down, "select"), keymap.Describe(sel, "go"), keymap.Describe(back, "close"))
+}
+
+// ApplyListKeys makes a list follow the active key map for moving and filtering.
+// Its help key opens the full help, which the app handles before the list sees it.
+func ApplyListKeys(l *list.Model) {
+	km := keymap.Current()
+	l.KeyMap.CursorUp = km.Up
+	l.KeyMap.CursorDown = km.Down
+	l.KeyMap.Filter = km.Filter
+	l.KeyMap.Quit = km.Quit
+	l.KeyMap.ShowFullHelp = key.NewBinding(key.WithKeys(km.Help.Keys()...), key.WithHelp(km.Help.Help().Key, "help"))
+	l.KeyMap.CloseFullHelp = l.KeyMap.ShowFullHelp
+}
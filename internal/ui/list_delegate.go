@@ -11,17 +11,18 @@ import (
 // Use consolidated neon colors from neon_styles.go
 // These aliases are kept for backward compatibility but reference the main color definitions
 var (
-	delegateNeonRed   = neonRed
-	delegateNeonCyan  = neonCyan
-	delegateNeonWhite = neonWhite
-	delegateNeonGray  = neonDim
-	delegateNeonDim   = neonDimGray
+	delegateNeonRed   lipgloss.AdaptiveColor
+	delegateNeonCyan  lipgloss.AdaptiveColor
+	delegateNeonWhite lipgloss.AdaptiveColor
+	delegateNeonGray  lipgloss.AdaptiveColor
+	delegateNeonDim   lipgloss.AdaptiveColor
 )
 
 // NewMatchListDelegate creates a custom list delegate for match items.
 // Height is set to 3 to accommodate title + 2-line description (with KO time).
 // Uses Neon Gradient styling: red title, cyan description on selection.
-func NewMatchListDelegate() list.DefaultDelegate {
+// Items can be clicked (see ItemAt).
+func NewMatchListDelegate() list.ItemDelegate {
 	d := list.NewDefaultDelegate()
 
 	// Set height to 3 lines: title (1) + description with KO time (2)
@@ -64,7 +65,7 @@ func NewMatchListDelegate() list.DefaultDelegate {
 		Bold(true).
 		Underline(true)
 
-	return d
+	return withItemZones(d)
 }
 
 // LeagueListDelegate is a custom delegate that renders checkboxes separately from titles.
@@ -2,10 +2,12 @@ package ui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
@@ -89,7 +91,7 @@ func RenderLiveMatchesListPanel(width, height int, listModel list.Model, upcomin
 		content = truncateToHeight(content, totalInnerHeight)
 	}
 
-	return neonPanelStyle.Width(width).Height(height).Render(content)
+	return markZone(ZoneMatchList, neonPanelStyle.Width(width).Height(height).Render(content))
 }
 
 func renderUpcomingMatchLine(match MatchDisplay, maxWidth int) string {
@@ -162,26 +164,23 @@ func RenderStatsListPanel(width, height int, finishedList list.Model, dateRange
 		panel = neonPanelStyle.Width(width).Height(height).Render(content)
 	}
 
-	return panel
+	return markZone(ZoneMatchList, panel)
 }
 
+// renderDateRangeSelector renders the configured date ranges (stats.date_ranges), e.g. "Today  3d  5d".
 func renderDateRangeSelector(width int, selected int) string {
-	options := []struct {
-		days  int
-		label string
-	}{
-		{1, "Today"},
-		{3, "3d"},
-		{5, "5d"},
-	}
-
-	items := make([]string, 0, len(options))
-	for _, opt := range options {
-		if opt.days == selected {
-			items = append(items, neonDateSelectedStyle.Render(opt.label))
-		} else {
-			items = append(items, neonDateUnselectedStyle.Render(opt.label))
+	ranges := data.CurrentConfig().Stats.DateRanges
+	items := make([]string, 0, len(ranges))
+	for _, days := range ranges {
+		label := fmt.Sprintf("%dd", days)
+		if days == 1 {
+			label = "Today"
+		}
+		style := neonDateUnselectedStyle
+		if days == selected {
+			style = neonDateSelectedStyle
 		}
+		items = append(items, markZone("range:"+strconv.Itoa(days), style.Render(label)))
 	}
 
 	selector := strings.Join(items, "  ")
@@ -189,7 +188,7 @@ func renderDateRangeSelector(width int, selected int) string {
 }
 
 // RenderMultiPanelViewWithList renders the live matches view with list component.
-func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
+func RenderMultiPanelViewWithList(width, height int, listModel list.Model, details *api.MatchDetails, liveUpdates []api.MatchEvent, sp spinner.Model, loading bool, randomSpinner *RandomCharSpinner, viewLoading bool, leaguesLoaded int, totalLeagues int, pollingSpinner *RandomCharSpinner, isPolling bool, upcomingMatches []MatchDisplay, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
 	if width <= 0 {
 		width = 80
 	}
@@ -315,12 +314,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 	visibleContent := strings.Join(visibleLines, "\n")
 
 	// Add context-aware help hint at bottom of panel content
-	var helpText string
-	if rightPanelFocused {
-		helpText = constants.HelpStatsViewFocused
-	} else {
-		helpText = constants.HelpStatsViewUnfocused
-	}
+	helpText := statsDetailsHelp(rightPanelFocused)
 	helpStyle := neonDimStyle.Width(rightWidth - 4).Align(lipgloss.Center).MarginTop(1)
 	helpRendered := helpStyle.Render(helpText)
 
@@ -345,6 +339,7 @@ func RenderStatsViewWithList(width, height int, finishedList list.Model, details
 			MaxHeight(panelHeight).
 			Render(rightPanel)
 	}
+	rightPanel = markZone(ZoneDetails, rightPanel)
 
 	separatorStyle := neonSeparatorStyle.Height(panelHeight)
 	separator := separatorStyle.Render("┃")
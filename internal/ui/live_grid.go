@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	"github.com/0xjuanma/golazo/internal/ui/design"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// minGridTileWidth is the narrowest a grid tile gets before the grid uses fewer columns.
+const minGridTileWidth = 34
+
+// GridTile is a match shown in the live view's grid mode.
+type GridTile struct {
+	Match   api.Match         // From the live matches list, shown until details arrive
+	Details *api.MatchDetails // Nil until loaded
+	Updates []api.MatchEvent  // Parsed events, newest first
+	Loading bool              // A refresh is in flight
+}
+
+// GridColumns returns the number of columns used to lay out n tiles in width.
+// Up to three tiles share a row; more are split over two rows.
+func GridColumns(n, width int) int {
+	if n <= 0 {
+		return 1
+	}
+	rows := 1
+	if n > 3 {
+		rows = 2
+	}
+	cols := (n + rows - 1) / rows
+	return max(min(cols, width/minGridTileWidth), 1)
+}
+
+// RenderLiveGridView renders the live view's grid mode: several live matches side
+// by side, each with its scoreline and latest events.
+func RenderLiveGridView(width, height int, tiles []GridTile, focused int, goalLinks GoalLinksMap, bannerType constants.StatusBannerType) string {
+	if width <= 0 {
+		width = 80
+	}
+	if height <= 0 {
+		height = 24
+	}
+
+	title := design.RenderHeader(fmt.Sprintf("%s (%d)", constants.PanelLiveGrid, len(tiles)), width-2)
+	statusBanner := renderStatusBanner(bannerType, width)
+	help := neonDimStyle.Width(width).Align(lipgloss.Center).Render(liveGridHelp())
+
+	chrome := lipgloss.Height(title) + lipgloss.Height(help) + 1
+	if statusBanner != "" {
+		chrome += lipgloss.Height(statusBanner)
+	}
+	bodyHeight := max(height-chrome, minPanelHeight)
+
+	cols := GridColumns(len(tiles), width)
+	rows := max((len(tiles)+cols-1)/cols, 1)
+	tileWidth := width / cols
+	tileHeight := bodyHeight / rows
+
+	var rowViews []string
+	for r := range rows {
+		var cells []string
+		for c := range cols {
+			i := r*cols + c
+			if i >= len(tiles) {
+				break
+			}
+			tile := renderGridTile(tiles[i], tileWidth, tileHeight, i == focused, goalLinks)
+			cells = append(cells, markZone("tile:"+strconv.Itoa(i), tile))
+		}
+		rowViews = append(rowViews, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	body := lipgloss.NewStyle().Height(bodyHeight).Render(lipgloss.JoinVertical(lipgloss.Left, rowViews...))
+
+	parts := []string{" " + title}
+	if statusBanner != "" {
+		parts = append(parts, statusBanner)
+	}
+	parts = append(parts, body, "", help)
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// renderGridTile renders one match of the grid in a bordered box: the teams, status
+// line and score as in the match details panel, then as many of the latest events as fit.
+func renderGridTile(tile GridTile, width, height int, focused bool, goalLinks GoalLinksMap) string {
+	innerWidth := max(width-2, 1)
+	innerHeight := max(height-2, 1)
+	contentWidth := max(innerWidth-2, 1)
+
+	match := tile.Match
+	if tile.Details != nil {
+		match = tile.Details.Match
+	}
+	home := match.HomeTeam.ShortName
+	if home == "" {
+		home = match.HomeTeam.Name
+	}
+	away := match.AwayTeam.ShortName
+	if away == "" {
+		away = match.AwayTeam.Name
+	}
+
+	lines := []string{
+		renderPanelHeader(Truncate(home+" vs "+away, contentWidth-4), focused, contentWidth),
+	}
+
+	if tile.Details == nil {
+		loading := lipgloss.NewStyle().Foreground(neonDim).Width(contentWidth).Align(lipgloss.Center).PaddingTop(1).Render(constants.LoadingFetching)
+		lines = append(lines, loading)
+	} else {
+		lines = append(lines, renderStatusLine(tile.Details, contentWidth))
+
+		// Short tiles keep their room for events: a one-line score and no updates title
+		compact := innerHeight < 12
+		if tile.Details.HomeScore != nil && tile.Details.AwayScore != nil {
+			if compact {
+				score := fmt.Sprintf("%d - %d", *tile.Details.HomeScore, *tile.Details.AwayScore)
+				lines = append(lines, lipgloss.NewStyle().Foreground(neonRed).Bold(true).Width(contentWidth).Align(lipgloss.Center).Render(score))
+			} else {
+				lines = append(lines, "", renderLargeScore(*tile.Details.HomeScore, *tile.Details.AwayScore, contentWidth))
+			}
+		}
+
+		updatesTitle := constants.PanelUpdates
+		if tile.Loading {
+			updatesTitle = "Updating..."
+		}
+		if !compact {
+			lines = append(lines, "")
+		}
+		if !compact || tile.Loading {
+			lines = append(lines, lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Render(updatesTitle))
+		}
+
+		if len(tile.Updates) == 0 {
+			lines = append(lines, neonDimStyle.Render(constants.EmptyNoUpdates))
+		}
+		for _, event := range tile.Updates {
+			if len(lines) >= innerHeight {
+				break
+			}
+			lines = append(lines, strings.Split(renderStyledLiveUpdate(event, contentWidth, tile.Details, goalLinks), "\n")...)
+		}
+	}
+
+	borderColor := neonDarkDim
+	if focused {
+		borderColor = neonRed
+	}
+	content := truncateToHeight(strings.Join(lines, "\n"), innerHeight)
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Width(innerWidth).
+		Height(innerHeight).
+		Render(content)
+}
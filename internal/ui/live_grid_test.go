@@ -0,0 +1,28 @@
+package ui
+
+import "testing"
+
+func TestGridColumns(t *testing.T) {
+	tests := []struct {
+		n     int
+		width int
+		want  int
+		desc  string
+	}{
+		{0, 200, 1, "no tiles"},
+		{1, 200, 1, "single tile"},
+		{2, 200, 2, "one row"},
+		{3, 200, 3, "three share a row"},
+		{4, 200, 2, "four over two rows"},
+		{5, 200, 3, "odd count rounds up"},
+		{6, 200, 3, "six over two rows"},
+		{6, 80, 2, "clamped by width"},
+		{3, 30, 1, "narrower than a tile"},
+	}
+
+	for _, tt := range tests {
+		if got := GridColumns(tt.n, tt.width); got != tt.want {
+			t.Errorf("GridColumns(%d, %d) = %d; want %d - %s", tt.n, tt.width, got, tt.want, tt.desc)
+		}
+	}
+}
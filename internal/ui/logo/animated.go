@@ -556,6 +556,11 @@ func renderWithMask(s string, mask []bool) string {
 	return result.String()
 }
 
+// Skip ends the animation, showing the full logo.
+func (a *AnimatedLogo) Skip() {
+	a.complete = true
+}
+
 // IsComplete returns whether the animation has finished.
 func (a *AnimatedLogo) IsComplete() bool {
 	return a.complete
@@ -23,7 +23,7 @@ type MatchDetailsConfig struct {
 	ShowHighlights bool // Stats view only
 
 	// Live view state
-	LiveUpdates    []string
+	LiveUpdates    []api.MatchEvent
 	PollingSpinner *RandomCharSpinner
 	IsPolling      bool
 	Loading        bool
@@ -91,6 +91,11 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 		headerLines = append(headerLines, renderPenaltiesSection(details, contentWidth)...)
 	}
 
+	// Kick-by-kick penalty shootout (live and finished)
+	if len(details.Shootout) > 0 {
+		scrollableLines = append(scrollableLines, renderShootoutSection(details, contentWidth))
+	}
+
 	// For live matches, show live updates instead of event details
 	if details.Status == api.MatchStatusLive || details.Status == api.MatchStatusNotStarted {
 		liveSection := renderLiveUpdatesSection(cfg, contentWidth)
@@ -204,9 +209,23 @@ func renderPenaltiesSection(details *api.MatchDetails, contentWidth int) []strin
 	lines = append(lines, penaltyHeader)
 
 	penaltyScoreText := fmt.Sprintf("%d - %d", *details.Penalties.Home, *details.Penalties.Away)
+	scoreStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+	penaltyScoreText = scoreStyle.Render(penaltyScoreText)
+
+	// Show each team's kicks as dots either side of the score
+	if len(details.Shootout) > 0 {
+		var homeDots, awayDots []string
+		for _, kick := range details.Shootout {
+			if kick.IsHome {
+				homeDots = append(homeDots, renderKickDot(kick))
+			} else {
+				awayDots = append(awayDots, renderKickDot(kick))
+			}
+		}
+		penaltyScoreText = strings.Join(homeDots, " ") + "   " + penaltyScoreText + "   " + strings.Join(awayDots, " ")
+	}
+
 	penaltyScore := lipgloss.NewStyle().
-		Foreground(neonCyan).
-		Bold(true).
 		Width(contentWidth).
 		Align(lipgloss.Center).
 		Render(penaltyScoreText)
@@ -216,11 +235,46 @@ func renderPenaltiesSection(details *api.MatchDetails, contentWidth int) []strin
 	return lines
 }
 
+// renderKickDot renders a shootout kick as a filled (scored) or hollow (missed) dot.
+func renderKickDot(kick api.PenaltyKick) string {
+	if kick.Scored {
+		return lipgloss.NewStyle().Foreground(neonCyan).Render("●")
+	}
+	return lipgloss.NewStyle().Foreground(neonRed).Render("○")
+}
+
+// renderShootoutSection renders the penalty shootout kick by kick, centered on the running score.
+func renderShootoutSection(details *api.MatchDetails, contentWidth int) string {
+	var lines []string
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render("Penalty Shootout"))
+
+	for _, kick := range details.Shootout {
+		player := kick.Player
+		if player == "" {
+			player = "Unknown"
+		}
+
+		var kickContent string
+		if kick.Scored {
+			kickContent = buildEventContent(neonValueStyle.Render(player), "", renderKickDot(kick), lipgloss.NewStyle().Foreground(neonCyan).Render("SCORED"), kick.IsHome)
+		} else {
+			kickContent = buildEventContent(neonDimStyle.Render(player), "", renderKickDot(kick), neonRedCardStyle.Render("MISSED"), kick.IsHome)
+		}
+
+		score := fmt.Sprintf("%d-%d", kick.HomeScore, kick.AwayScore)
+		lines = append(lines, renderCenterAlignedEvent(score, kickContent, kick.IsHome, contentWidth))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
 func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	details := cfg.Details
 	var goals []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "goal" {
+		switch event.Kind {
+		case api.EventKindGoal, api.EventKindDisallowedGoal, api.EventKindMissedPenalty:
 			goals = append(goals, event)
 		}
 	}
@@ -234,24 +288,7 @@ func renderGoalsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	lines = append(lines, neonHeaderStyle.Render("Goals"))
 
 	for _, goal := range goals {
-		player := "Unknown"
-		if goal.Player != nil {
-			player = *goal.Player
-		}
-		isHome := goal.Team.ID == details.HomeTeam.ID
-
-		playerDetails := neonValueStyle.Render(player)
-		replayIndicator := getReplayIndicator(details, cfg.GoalLinks, goal.Minute)
-
-		// Use gradient for GOAL label
-		styledGoal := design.ApplyGradientToText("GOAL")
-		goalContent := buildEventContent(playerDetails, replayIndicator, "●", styledGoal, isHome)
-
-		minuteStr := goal.DisplayMinute
-		if minuteStr == "" {
-			minuteStr = fmt.Sprintf("%d'", goal.Minute)
-		}
-		lines = append(lines, renderCenterAlignedEvent(minuteStr, goalContent, isHome, contentWidth))
+		lines = append(lines, renderStyledLiveUpdate(goal, contentWidth, details, cfg.GoalLinks))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -261,7 +298,7 @@ func renderCardsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	details := cfg.Details
 	var cardEvents []api.MatchEvent
 	for _, event := range details.Events {
-		if event.Type == "card" {
+		if event.Kind == api.EventKindCard {
 			cardEvents = append(cardEvents, event)
 		}
 	}
@@ -275,27 +312,7 @@ func renderCardsSection(cfg MatchDetailsConfig, contentWidth int) string {
 	lines = append(lines, neonHeaderStyle.Render("Cards"))
 
 	for _, card := range cardEvents {
-		player := "Unknown"
-		if card.Player != nil {
-			player = *card.Player
-		}
-		isHome := card.Team.ID == details.HomeTeam.ID
-
-		cardSymbol := CardSymbolYellow
-		cardStyle := neonYellowCardStyle
-		if card.EventType != nil && (*card.EventType == "red" || *card.EventType == "redcard" || *card.EventType == "secondyellow") {
-			cardSymbol = CardSymbolRed
-			cardStyle = neonRedCardStyle
-		}
-
-		playerDetails := neonValueStyle.Render(player)
-		cardContent := buildEventContent(playerDetails, "", cardSymbol, cardStyle.Render("CARD"), isHome)
-
-		minuteStr := card.DisplayMinute
-		if minuteStr == "" {
-			minuteStr = fmt.Sprintf("%d'", card.Minute)
-		}
-		lines = append(lines, renderCenterAlignedEvent(minuteStr, cardContent, isHome, contentWidth))
+		lines = append(lines, renderStyledLiveUpdate(card, contentWidth, details, cfg.GoalLinks))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -380,8 +397,8 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 			Render(constants.EmptyNoUpdates)
 		lines = append(lines, emptyUpdates)
 	} else if len(cfg.LiveUpdates) > 0 {
-		for _, update := range cfg.LiveUpdates {
-			updateLine := renderStyledLiveUpdate(update, contentWidth, cfg.Details, cfg.GoalLinks)
+		for _, event := range cfg.LiveUpdates {
+			updateLine := renderStyledLiveUpdate(event, contentWidth, cfg.Details, cfg.GoalLinks)
 			lines = append(lines, updateLine)
 		}
 	}
@@ -403,8 +420,9 @@ func renderStatProgressBar(label, homeVal, awayVal string, maxWidth int, homeTea
 		awayPercent = 100 - homePercent
 	}
 
+	startHex, endHex := AdaptiveGradientColors()
 	prog := progress.New(
-		progress.WithScaledGradient("#00FFFF", "#FF0055"),
+		progress.WithScaledGradient(startHex, endHex),
 		progress.WithWidth(statBarWidth),
 		progress.WithoutPercentage(),
 	)
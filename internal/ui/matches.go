@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
 )
 
 // MatchDisplay wraps a match with display information for rendering.
@@ -12,16 +13,25 @@ type MatchDisplay struct {
 	api.Match
 }
 
-// Title returns a formatted title for the match.
+// favoriteMarker prefixes the names of the active profile's favourite teams.
+const favoriteMarker = "★ "
+
+// Title returns a formatted title for the match, starring favourite teams.
 func (m MatchDisplay) Title() string {
 	home := m.HomeTeam.ShortName
 	if home == "" {
 		home = m.HomeTeam.Name
 	}
+	if data.IsFavoriteTeam(m.HomeTeam.ID) {
+		home = favoriteMarker + home
+	}
 	away := m.AwayTeam.ShortName
 	if away == "" {
 		away = m.AwayTeam.Name
 	}
+	if data.IsFavoriteTeam(m.AwayTeam.ID) {
+		away = favoriteMarker + away
+	}
 	return home + " vs " + away
 }
 
@@ -15,27 +15,36 @@ import (
 const logoWidth = 80
 
 var (
+	// Menu styles
+	menuItemStyle         lipgloss.Style
+	menuItemSelectedStyle lipgloss.Style
+	menuTitleStyle        lipgloss.Style
+	menuHelpStyle         lipgloss.Style
+)
+
+// buildMenuStyles builds the menu styles from the current palette.
+func buildMenuStyles() {
 	// Menu styles
 	menuItemStyle = lipgloss.NewStyle().
-			Foreground(textColor).
-			Padding(0, 0)
+		Foreground(textColor).
+		Padding(0, 0)
 
 	menuItemSelectedStyle = lipgloss.NewStyle().
-				Foreground(highlightColor).
-				Bold(true).
-				Padding(0, 0)
+		Foreground(highlightColor).
+		Bold(true).
+		Padding(0, 0)
 
 	menuTitleStyle = lipgloss.NewStyle().
-			Foreground(accentColor).
-			Bold(true).
-			Align(lipgloss.Center).
-			Padding(0, 0)
+		Foreground(accentColor).
+		Bold(true).
+		Align(lipgloss.Center).
+		Padding(0, 0)
 
 	menuHelpStyle = lipgloss.NewStyle().
-			Foreground(dimColor).
-			Align(lipgloss.Center).
-			Padding(0, 0)
-)
+		Foreground(dimColor).
+		Align(lipgloss.Center).
+		Padding(0, 0)
+}
 
 // RenderMainMenu renders the main menu view with navigation options.
 // width and height specify the terminal dimensions.
@@ -71,7 +80,7 @@ func RenderMainMenu(width, height, selected int, sp spinner.Model, randomSpinner
 		Width(logoWidth).
 		Align(lipgloss.Center).
 		Render(logoContent)
-	help := menuHelpStyle.Render(constants.HelpMainMenu)
+	help := menuHelpStyle.Render(mainMenuHelp())
 
 	// Spinner with fixed spacing - always reserve space to prevent movement
 	// Use multiple spinner instances for a longer, more prominent animation
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"github.com/0xjuanma/golazo/internal/theme"
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -15,126 +16,202 @@ const (
 )
 
 var (
-	// Neon color palette - Golazo brand
+	// Neon color palette - Golazo brand, taken from the active theme (see ApplyTheme).
 	// Primary colors - adaptive for light/dark terminals
-	neonRed    = lipgloss.AdaptiveColor{Light: "124", Dark: "196"} // Dark red / Bright red
-	neonCyan   = lipgloss.AdaptiveColor{Light: "23", Dark: "51"}   // Darker cyan / Electric cyan
-	neonYellow = lipgloss.AdaptiveColor{Light: "136", Dark: "226"} // Dark gold / Bright yellow for cards
+	neonRed    lipgloss.AdaptiveColor // Dark red / Bright red in the default theme
+	neonCyan   lipgloss.AdaptiveColor // Darker cyan / Electric cyan
+	neonYellow lipgloss.AdaptiveColor // Dark gold / Bright yellow for cards
 	// Adaptive white - dark gray on light terminals, white on dark terminals
-	neonWhite = lipgloss.AdaptiveColor{Light: "235", Dark: "255"} // Adaptive text color
+	neonWhite lipgloss.AdaptiveColor // Adaptive text color
 	// Adaptive white alt - slightly different shades for variety
-	neonWhiteAlt = lipgloss.AdaptiveColor{Light: "236", Dark: "15"} // Standard adaptive text
-	neonBlack    = lipgloss.Color("0")                              // Black
+	neonWhiteAlt lipgloss.AdaptiveColor // Standard adaptive text
+	neonBlack    lipgloss.AdaptiveColor // Black
 
 	// Gray scale - adaptive for light/dark terminals
-	neonDark    = lipgloss.AdaptiveColor{Light: "252", Dark: "236"} // Light gray / Dark background
-	neonDarkDim = lipgloss.AdaptiveColor{Light: "249", Dark: "239"} // Light gray / Slightly lighter dark
-	neonGray    = lipgloss.AdaptiveColor{Light: "245", Dark: "240"} // Medium gray (visible on both)
-	neonDim     = lipgloss.AdaptiveColor{Light: "243", Dark: "244"} // Gray dim text
-	neonDimGray = lipgloss.AdaptiveColor{Light: "246", Dark: "238"} // Dim gray (for delegates)
+	neonDark    lipgloss.AdaptiveColor // Light gray / Dark background
+	neonDarkDim lipgloss.AdaptiveColor // Light gray / Slightly lighter dark
+	neonGray    lipgloss.AdaptiveColor // Medium gray (visible on both)
+	neonDim     lipgloss.AdaptiveColor // Gray dim text
+	neonDimGray lipgloss.AdaptiveColor // Dim gray (for delegates)
+)
+
+// applyNeonColors sets the neon palette from a theme.
+func applyNeonColors(t theme.Theme) {
+	neonRed = t.Primary
+	neonCyan = t.Accent
+	neonYellow = t.Warning
+	neonWhite = t.Text
+	neonWhiteAlt = t.TextAlt
+	neonBlack = t.Black
+
+	neonDark = t.Surface
+	neonDarkDim = t.SurfaceDim
+	neonGray = t.Muted
+	neonDim = t.Dim
+	neonDimGray = t.Subtle
+}
+
+var (
+	// Card styles - reusable across all views
+	neonYellowCardStyle lipgloss.Style
+	neonRedCardStyle    lipgloss.Style
+
+	// Neon panel style - thick red border
+	neonPanelStyle lipgloss.Style
+
+	// Neon panel style - cyan variant (no border for right panels)
+	neonPanelCyanStyle lipgloss.Style
+
+	// Neon panel title style - red accent
+	neonPanelTitleStyle lipgloss.Style
+
+	// Neon section style - cyan borders for inner sections
+	neonSectionStyle lipgloss.Style
+
+	// Neon header style - cyan
+	neonHeaderStyle lipgloss.Style
+
+	// Neon subtitle style - cyan italic
+	neonSubtitleStyle lipgloss.Style
+
+	// Neon team style - cyan for team names
+	neonTeamStyle lipgloss.Style
+
+	// Neon score style - red for emphasis
+	neonScoreStyle lipgloss.Style
+
+	// Neon value style - white text
+	neonValueStyle lipgloss.Style
+
+	// Neon dim style - gray text
+	neonDimStyle lipgloss.Style
+
+	// Neon label style - dim with fixed width
+	neonLabelStyle lipgloss.Style
+
+	// Neon list item styles
+	neonListItemStyle         lipgloss.Style
+	neonListItemSelectedStyle lipgloss.Style
+
+	// Neon status styles
+	neonLiveStyle     lipgloss.Style
+	neonFinishedStyle lipgloss.Style
+
+	// Neon separator style
+	neonSeparatorStyle lipgloss.Style
 
+	// Neon empty state style
+	neonEmptyStyle lipgloss.Style
+
+	// Neon date selector styles
+	neonDateSelectedStyle   lipgloss.Style
+	neonDateUnselectedStyle lipgloss.Style
+)
+
+// buildNeonStyles builds the neon styles from the current palette.
+func buildNeonStyles() {
 	// Card styles - reusable across all views
 	neonYellowCardStyle = lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
-	neonRedCardStyle    = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+	neonRedCardStyle = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
 
 	// Neon panel style - thick red border
 	neonPanelStyle = lipgloss.NewStyle().
-			Border(lipgloss.NormalBorder()).
-			BorderForeground(neonRed).
-			Padding(0, 1)
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(neonRed).
+		Padding(0, 1)
 
 	// Neon panel style - cyan variant (no border for right panels)
 	neonPanelCyanStyle = lipgloss.NewStyle().
-				Padding(0, 1)
+		Padding(0, 1)
 
 	// Neon panel title style - red accent
 	neonPanelTitleStyle = lipgloss.NewStyle().
-				Foreground(neonRed).
-				Bold(true).
-				PaddingBottom(0).
-				BorderBottom(true).
-				BorderStyle(lipgloss.NormalBorder()).
-				BorderForeground(neonDarkDim).
-				MarginBottom(0)
+		Foreground(neonRed).
+		Bold(true).
+		PaddingBottom(0).
+		BorderBottom(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(neonDarkDim).
+		MarginBottom(0)
 
 	// Neon section style - cyan borders for inner sections
 	neonSectionStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(neonCyan).
-				Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(neonCyan).
+		Padding(0, 1)
 
 	// Neon header style - cyan
 	neonHeaderStyle = lipgloss.NewStyle().
-			Foreground(neonCyan).
-			Bold(true)
+		Foreground(neonCyan).
+		Bold(true)
 
 	// Neon subtitle style - cyan italic
 	neonSubtitleStyle = lipgloss.NewStyle().
-				Foreground(neonCyan).
-				Italic(true)
+		Foreground(neonCyan).
+		Italic(true)
 
 	// Neon team style - cyan for team names
 	neonTeamStyle = lipgloss.NewStyle().
-			Foreground(neonCyan).
-			Bold(true)
+		Foreground(neonCyan).
+		Bold(true)
 
 	// Neon score style - red for emphasis
 	neonScoreStyle = lipgloss.NewStyle().
-			Foreground(neonRed).
-			Bold(true)
+		Foreground(neonRed).
+		Bold(true)
 
 	// Neon value style - white text
 	neonValueStyle = lipgloss.NewStyle().
-			Foreground(neonWhite)
+		Foreground(neonWhite)
 
 	// Neon dim style - gray text
 	neonDimStyle = lipgloss.NewStyle().
-			Foreground(neonDim)
+		Foreground(neonDim)
 
 	// Neon label style - dim with fixed width
 	neonLabelStyle = lipgloss.NewStyle().
-			Foreground(neonDim).
-			Width(14)
+		Foreground(neonDim).
+		Width(14)
 
 	// Neon list item styles
 	neonListItemStyle = lipgloss.NewStyle().
-				Foreground(neonWhite).
-				Padding(0, 1)
+		Foreground(neonWhite).
+		Padding(0, 1)
 
 	neonListItemSelectedStyle = lipgloss.NewStyle().
-					Foreground(neonRed).
-					Bold(true).
-					Padding(0, 1)
+		Foreground(neonRed).
+		Bold(true).
+		Padding(0, 1)
 
 	// Neon status styles
 	neonLiveStyle = lipgloss.NewStyle().
-			Foreground(neonRed).
-			Bold(true)
+		Foreground(neonRed).
+		Bold(true)
 
 	neonFinishedStyle = lipgloss.NewStyle().
-				Foreground(neonCyan)
+		Foreground(neonCyan)
 
 	// Neon separator style
 	neonSeparatorStyle = lipgloss.NewStyle().
-				Foreground(neonRed).
-				Padding(0, 1)
+		Foreground(neonRed).
+		Padding(0, 1)
 
 	// Neon empty state style
 	neonEmptyStyle = lipgloss.NewStyle().
-			Foreground(neonDim).
-			Padding(2, 2).
-			Align(lipgloss.Center)
+		Foreground(neonDim).
+		Padding(2, 2).
+		Align(lipgloss.Center)
 
 	// Neon date selector styles
 	neonDateSelectedStyle = lipgloss.NewStyle().
-				Foreground(neonRed).
-				Bold(true).
-				Padding(0, 1)
+		Foreground(neonRed).
+		Bold(true).
+		Padding(0, 1)
 
 	neonDateUnselectedStyle = lipgloss.NewStyle().
-				Foreground(neonDim).
-				Padding(0, 1)
-)
+		Foreground(neonDim).
+		Padding(0, 1)
+}
 
 // FilterInputStyles returns cursor and prompt styles for list filter input.
 // Cursor: neon cyan (solid color), Prompt: neon red to match theme.
@@ -2,7 +2,6 @@ package ui
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -26,14 +25,23 @@ func getReplayIndicator(details *api.MatchDetails, goalLinks GoalLinksMap, minut
 
 // buildEventContent structures event content with symbol+type adjacent to center time.
 func buildEventContent(playerDetails string, replayIndicator string, symbol string, styledTypeLabel string, isHome bool) string {
+	// In accessible mode the label alone names the event; symbols would be read out as noise
+	showSymbol := !accessible || styledTypeLabel == ""
+
 	if isHome {
 		result := playerDetails
 		if replayIndicator != "" {
 			result += " " + replayIndicator
 		}
-		return result + " " + symbol + " " + styledTypeLabel
+		if showSymbol {
+			result += " " + symbol
+		}
+		return result + " " + styledTypeLabel
+	}
+	result := styledTypeLabel
+	if showSymbol {
+		result += " " + symbol
 	}
-	result := styledTypeLabel + " " + symbol
 	if replayIndicator != "" {
 		result += " " + replayIndicator
 	}
@@ -73,12 +81,12 @@ func renderCenterAlignedEvent(minuteStr string, eventContent string, isHomeTeam
 }
 
 // renderMatchDetailsPanelWithPolling renders the right panel with polling spinner support.
-func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []api.MatchEvent, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
 	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks)
 }
 
 // renderMatchDetailsPanelFull renders the right panel with match details using unified rendering.
-func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []api.MatchEvent, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
 	detailsPanelStyle := lipgloss.NewStyle().Padding(0, 1)
 
 	if details == nil {
@@ -134,132 +142,122 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		Render(panelContent)
 }
 
-// extractTeamMarker extracts the [H] or [A] marker from the end of an update string.
-func extractTeamMarker(update string) (string, bool) {
-	if before, ok := strings.CutSuffix(update, " [H]"); ok {
-		return before, true
+// isHomeEvent reports whether an event belongs to the home team.
+func isHomeEvent(event api.MatchEvent, details *api.MatchDetails) bool {
+	if details == nil {
+		return true
 	}
-	if before, ok := strings.CutSuffix(update, " [A]"); ok {
-		return before, false
+	if event.Team.ID == 0 && event.Team.ShortName != "" {
+		// Fallback to short name matching if ID not set
+		return event.Team.ShortName == details.HomeTeam.ShortName
 	}
-	return update, true
+	return event.Team.ID == details.HomeTeam.ID
 }
 
-// extractMinuteFromUpdate extracts the minute string from a live update.
-func extractMinuteFromUpdate(update string) (minute string, rest string) {
-	parts := strings.SplitN(update, "' ", 2)
-	if len(parts) != 2 {
-		return "", update
+// eventMinute returns the display minute of an event (e.g. "45+2'").
+func eventMinute(event api.MatchEvent) string {
+	if event.DisplayMinute != "" {
+		return event.DisplayMinute
 	}
-
-	firstPart := parts[0]
-	lastSpace := strings.LastIndex(firstPart, " ")
-	if lastSpace == -1 {
-		return "", update
-	}
-
-	minute = firstPart[lastSpace+1:] + "'"
-	prefix := firstPart[:lastSpace]
-	rest = prefix + " " + parts[1]
-
-	return minute, rest
+	return fmt.Sprintf("%d'", event.Minute)
 }
 
-// renderStyledLiveUpdate renders a live update string with appropriate colors.
-func renderStyledLiveUpdate(update string, contentWidth int, details *api.MatchDetails, goalLinks GoalLinksMap) string {
-	if len(update) == 0 {
-		return update
+// eventPlayerName returns the main player of an event, or "Unknown".
+func eventPlayerName(event api.MatchEvent) string {
+	if event.Player != nil && *event.Player != "" {
+		return *event.Player
 	}
+	return "Unknown"
+}
 
-	cleanUpdate, isHome := extractTeamMarker(update)
-	minute, contentWithoutMinute := extractMinuteFromUpdate(cleanUpdate)
-	if minute == "" {
-		minute = "0'"
-		contentWithoutMinute = cleanUpdate
-	}
+// renderStyledLiveUpdate renders a timeline event centered on its minute, with colors by kind.
+func renderStyledLiveUpdate(event api.MatchEvent, contentWidth int, details *api.MatchDetails, goalLinks GoalLinksMap) string {
+	isHome := isHomeEvent(event, details)
+	content := renderEventContent(event, isHome, details, goalLinks)
+	return renderCenterAlignedEvent(eventMinute(event), content, isHome, contentWidth)
+}
 
-	runes := []rune(contentWithoutMinute)
-	symbol := string(runes[0])
-	whiteStyle := lipgloss.NewStyle().Foreground(neonWhite)
+// renderEventContent renders the symbol, label and player details of an event.
+func renderEventContent(event api.MatchEvent, isHome bool, details *api.MatchDetails, goalLinks GoalLinksMap) string {
+	player := eventPlayerName(event)
 
-	var styledContent string
-	switch symbol {
-	case "●": // Goal - gradient
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[GOAL]")
+	switch event.Kind {
+	case api.EventKindGoal:
+		replayIndicator := getReplayIndicator(details, goalLinks, event.Minute)
+		if event.IsOwnGoal {
+			return buildEventContent(neonValueStyle.Render(player), replayIndicator, "◎", neonRedCardStyle.Render("OWN GOAL"), isHome)
+		}
+		if event.IsPenalty {
+			player += " (P)"
+		}
 		styledType := design.ApplyGradientToText("GOAL")
-		styledPlayer := whiteStyle.Render(playerDetails)
+		return buildEventContent(neonValueStyle.Render(player), replayIndicator, "●", styledType, isHome)
 
-		replayIndicator := ""
-		if details != nil && goalLinks != nil {
-			minuteStr := strings.TrimSuffix(minute, "'")
-			if minuteInt, err := strconv.Atoi(minuteStr); err == nil {
-				replayIndicator = getReplayIndicator(details, goalLinks, minuteInt)
-			}
+	case api.EventKindDisallowedGoal:
+		if event.Decision != nil && *event.Decision != "" {
+			player = fmt.Sprintf("%s (%s)", player, *event.Decision)
 		}
+		label := lipgloss.NewStyle().Foreground(neonDim).Strikethrough(true).Render("GOAL")
+		if accessible {
+			label = neonDimStyle.Render("NO GOAL") // Strikethrough is lost on screen readers
+		}
+		return buildEventContent(neonDimStyle.Render(player), "", "⊘", label, isHome)
 
-		styledContent = buildEventContent(styledPlayer, replayIndicator, symbol, styledType, isHome)
-	case "▪": // Yellow card
-		cardStyle := lipgloss.NewStyle().Foreground(neonYellow).Bold(true)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
-		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
-	case "■": // Red card
-		cardStyle := lipgloss.NewStyle().Foreground(neonRed).Bold(true)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "[CARD]")
-		styledContent = buildEventContent(whiteStyle.Render(playerDetails), "", symbol, cardStyle.Render("CARD"), isHome)
-	case "↔": // Substitution
-		styledContent = renderSubstitutionWithColorsNoMinute(contentWithoutMinute, isHome)
-	case "·": // Other
-		dimStyle := lipgloss.NewStyle().Foreground(neonDim)
-		playerDetails, _ := extractPlayerAndType(contentWithoutMinute, "")
-		styledContent = buildEventContent(dimStyle.Render(playerDetails), "", symbol, "", isHome)
-	default:
-		styledContent = whiteStyle.Render(contentWithoutMinute)
-	}
-
-	return renderCenterAlignedEvent(minute, styledContent, isHome, contentWidth)
-}
+	case api.EventKindMissedPenalty:
+		return buildEventContent(neonValueStyle.Render(player), "", "○", neonDimStyle.Render("PEN MISSED"), isHome)
 
-// extractPlayerAndType extracts player details and type label from event content.
-func extractPlayerAndType(content string, typeMarker string) (string, string) {
-	if typeMarker == "" {
-		runes := []rune(content)
-		if len(runes) > 1 {
-			return strings.TrimSpace(string(runes[1:])), ""
+	case api.EventKindVAR:
+		decision := "Review"
+		if event.Decision != nil && *event.Decision != "" {
+			decision = *event.Decision
 		}
-		return "", ""
-	}
+		varStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+		return buildEventContent(neonValueStyle.Render(decision), "", "◇", varStyle.Render("VAR"), isHome)
+
+	case api.EventKindCard:
+		if accessible {
+			label := strings.ToUpper(cardLabel(event.Card))
+			if event.Card.IsRed() {
+				return buildEventContent(neonValueStyle.Render(player), "", "", neonRedCardStyle.Render(label), isHome)
+			}
+			return buildEventContent(neonValueStyle.Render(player), "", "", neonYellowCardStyle.Render(label), isHome)
+		}
+		if event.Card.IsRed() {
+			return buildEventContent(neonValueStyle.Render(player), "", CardSymbolRed, neonRedCardStyle.Render("CARD"), isHome)
+		}
+		return buildEventContent(neonValueStyle.Render(player), "", CardSymbolYellow, neonYellowCardStyle.Render("CARD"), isHome)
+
+	case api.EventKindSubstitution:
+		return renderSubstitution(event, isHome)
 
-	_, after, ok := strings.Cut(content, typeMarker)
-	if !ok {
-		runes := []rune(content)
-		if len(runes) > 1 {
-			return strings.TrimSpace(string(runes[1:])), ""
+	default:
+		detail := event.Detail
+		if event.Player != nil && *event.Player != "" {
+			detail = *event.Player
 		}
-		return "", ""
+		return buildEventContent(neonDimStyle.Render(detail), "", "·", "", isHome)
 	}
-
-	return strings.TrimSpace(after), typeMarker
 }
 
-// renderSubstitutionWithColorsNoMinute renders a substitution without the minute.
-func renderSubstitutionWithColorsNoMinute(update string, isHome bool) string {
-	dimStyle := lipgloss.NewStyle().Foreground(neonDim)
+// renderSubstitution renders a substitution with the player coming in and going out.
+func renderSubstitution(event api.MatchEvent, isHome bool) string {
 	outStyle := lipgloss.NewStyle().Foreground(neonRed)
 	inStyle := lipgloss.NewStyle().Foreground(neonCyan)
 
-	outIdx := strings.Index(update, "{OUT}")
-	inIdx := strings.Index(update, "{IN}")
-
-	if outIdx == -1 || inIdx == -1 {
-		return dimStyle.Render(update)
+	playerIn := "Unknown"
+	if event.PlayerIn != nil && event.PlayerIn.Name != "" {
+		playerIn = event.PlayerIn.Name
+	}
+	in, out := "←", "→"
+	if accessible {
+		in, out = "on ", "off "
+	}
+	playerDetails := inStyle.Render(in + playerIn)
+	if event.PlayerOut != nil && event.PlayerOut.Name != "" {
+		playerDetails += " " + outStyle.Render(out+event.PlayerOut.Name)
 	}
 
-	playerOut := strings.TrimSpace(update[outIdx+5 : inIdx])
-	playerIn := strings.TrimSpace(update[inIdx+4:])
-
-	playerDetails := inStyle.Render("←"+playerIn) + " " + outStyle.Render("→"+playerOut)
-
-	return buildEventContent(playerDetails, "", "↔", dimStyle.Render("SUB"), isHome)
+	return buildEventContent(playerDetails, "", "↔", neonDimStyle.Render("SUB"), isHome)
 }
 
 // renderLargeScore renders the score in a large, prominent format using block digits.
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/0xjuanma/golazo/internal/constants"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lucasb-eyer/go-colorful"
@@ -66,6 +67,10 @@ func NewRandomCharSpinner() *RandomCharSpinner {
 // Tick advances the spinner animation - randomizes all characters for trendy effect.
 // Does NOT return a tick command - the app manages the tick chain.
 func (r *RandomCharSpinner) Tick() {
+	if accessible {
+		return // Static in accessible mode
+	}
+
 	// Ensure display buffer matches width
 	if len(r.display) != r.width {
 		r.display = make([]rune, r.width)
@@ -79,6 +84,10 @@ func (r *RandomCharSpinner) Tick() {
 
 // View renders the spinner with gradient colors.
 func (r *RandomCharSpinner) View() string {
+	if accessible {
+		return neonDimStyle.Render(constants.LoadingFetching)
+	}
+
 	if r.width <= 0 {
 		r.width = 20
 	}
@@ -2,9 +2,12 @@ package ui
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/theme"
 	"github.com/0xjuanma/golazo/internal/ui/design"
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/lipgloss"
@@ -13,20 +16,33 @@ import (
 // Settings view uses the same neon colors as the rest of the app (red/cyan theme).
 // Minimal design without heavy borders.
 
+// settingsAllTab is the last settings tab, listing every known competition for searching.
+const settingsAllTab = "All"
+
+// SettingsTabs returns the names of the settings tabs: the regions, then the "All" tab.
+func SettingsTabs() []string {
+	return append(data.GetAllRegions(), settingsAllTab)
+}
+
 // SettingsState holds the state for the settings view.
 type SettingsState struct {
-	List          list.Model        // List component for league navigation
-	Selected      map[int]bool      // Map of league ID -> selected
-	Leagues       []data.LeagueInfo // All leagues for current region
-	AllLeagues    []data.LeagueInfo // All leagues across all regions
-	Regions       []string          // Available regions
-	CurrentRegion int               // Index of current region
-	HasChanges    bool              // Whether there are unsaved changes
+	List          list.Model                   // List component for league navigation
+	Selected      map[int]bool                 // Map of league ID -> selected
+	Leagues       []data.LeagueInfo            // All leagues for current region
+	AllLeagues    []data.LeagueInfo            // All leagues across all regions
+	Catalog       map[string][]data.LeagueInfo // Built-in and catalogue leagues by region
+	Regions       []string                     // Available regions, followed by the "All" tab
+	CurrentRegion int                          // Index of current region
+	HasChanges    bool                         // Whether there are unsaved changes
+	LoadErr       error                        // Why settings.yaml couldn't be read; saving is disabled
+	Profile       string                       // Profile whose settings are shown
+	Theme         string                       // Theme previewed, saved with the profile
 }
 
 // NewSettingsState creates a new settings state with current saved preferences.
 func NewSettingsState() *SettingsState {
-	settings, _ := data.LoadSettings()
+	// A corrupt settings file is kept as is rather than replaced by this selection
+	settings, loadErr := data.LoadSettings()
 
 	selected := make(map[int]bool)
 
@@ -38,16 +54,18 @@ func NewSettingsState() *SettingsState {
 		}
 	}
 
-	regions := data.GetAllRegions()
+	// Built-in leagues merged with the cached provider catalogue
+	catalog := data.Leagues()
+	regions := SettingsTabs()
 	currentRegion := 0 // Start with first region (Europe)
 
 	// Get all leagues for current region
-	leagues := data.GetLeaguesForRegion(regions[currentRegion])
+	leagues := catalog[regions[currentRegion]]
 
 	// Get all leagues across all regions for saving/loading
-	allLeagueInfos := make([]data.LeagueInfo, 0, len(data.AllLeagueIDs()))
-	for _, region := range regions {
-		allLeagueInfos = append(allLeagueInfos, data.GetLeaguesForRegion(region)...)
+	var allLeagueInfos []data.LeagueInfo
+	for _, region := range data.GetAllRegions() {
+		allLeagueInfos = append(allLeagueInfos, catalog[region]...)
 	}
 
 	// Create list items for current region
@@ -61,13 +79,14 @@ func NewSettingsState() *SettingsState {
 
 	// Create and configure the list
 	delegate := NewLeagueListDelegate()
-	l := list.New(items, delegate, 0, 0)
+	l := list.New(items, withItemZones(delegate), 0, 0)
 	l.SetShowTitle(false)
 	l.SetShowStatusBar(true)
 	l.SetFilteringEnabled(true)
 	l.SetShowFilter(true)
 	l.Filter = list.DefaultFilter
 	l.SetShowHelp(false) // We use our own help text
+	ApplyListKeys(&l)
 
 	// Apply filter input styles
 	filterCursorStyle, filterPromptStyle := FilterInputStyles()
@@ -80,8 +99,12 @@ func NewSettingsState() *SettingsState {
 		Selected:      selected,
 		Leagues:       leagues,
 		AllLeagues:    allLeagueInfos,
+		Catalog:       catalog,
 		Regions:       regions,
 		CurrentRegion: currentRegion,
+		LoadErr:       loadErr,
+		Profile:       data.ActiveProfile(),
+		Theme:         theme.Current().Name,
 	}
 }
 
@@ -113,13 +136,24 @@ func (s *SettingsState) switchToRegion(regionIndex int) {
 	}
 
 	s.CurrentRegion = regionIndex
-	s.Leagues = data.GetLeaguesForRegion(s.Regions[regionIndex])
+	if s.Regions[regionIndex] == settingsAllTab {
+		s.Leagues = s.AllLeagues
+	} else {
+		s.Leagues = s.Catalog[s.Regions[regionIndex]]
+	}
 	s.refreshListItems()
 
 	// Reset filter when switching regions
 	s.List.ResetFilter()
 }
 
+// SelectRegion switches to the region tab at index, e.g. when it is clicked.
+func (s *SettingsState) SelectRegion(index int) {
+	if index != s.CurrentRegion {
+		s.switchToRegion(index)
+	}
+}
+
 // NextRegion switches to the next region (with wraparound).
 func (s *SettingsState) NextRegion() {
 	nextRegion := (s.CurrentRegion + 1) % len(s.Regions)
@@ -135,20 +169,117 @@ func (s *SettingsState) PreviousRegion() {
 	s.switchToRegion(prevRegion)
 }
 
-// Save persists the current selection to settings.yaml.
+// NextProfile saves pending changes and switches to the next profile (with wraparound),
+// loading its selection. The active profile changes for the rest of the session.
+func (s *SettingsState) NextProfile() error {
+	if s.HasChanges {
+		if err := s.Save(); err != nil {
+			return err
+		}
+	}
+
+	profiles, err := data.Profiles()
+	if err != nil {
+		return err
+	}
+	next := profiles[0]
+	for i, name := range profiles {
+		if name == s.Profile {
+			next = profiles[(i+1)%len(profiles)]
+		}
+	}
+	if err := data.SetActiveProfile(next); err != nil {
+		return err
+	}
+
+	region := s.CurrentRegion
+	if err := ApplyTheme(data.ThemeName()); err != nil {
+		return err
+	}
+	*s = *NewSettingsState()
+	s.switchToRegion(region)
+	return nil
+}
+
+// NextTheme previews the next built-in theme (with wraparound).
+func (s *SettingsState) NextTheme() {
+	names := theme.Names()
+	next := names[0]
+	for i, name := range names {
+		if name == s.Theme {
+			next = names[(i+1)%len(names)]
+		}
+	}
+	s.PreviewTheme(next)
+}
+
+// PreviewTheme applies a built-in theme. It is kept when the settings are saved;
+// RevertTheme restores the saved one.
+func (s *SettingsState) PreviewTheme(name string) {
+	if name == s.Theme || ApplyTheme(name) != nil {
+		return
+	}
+	s.Theme = name
+	s.HasChanges = true
+	s.refreshStyles()
+}
+
+// RevertTheme restores the theme in effect before previewing, discarding the preview.
+func (s *SettingsState) RevertTheme() {
+	saved := data.ThemeName()
+	if s.Theme == saved {
+		return
+	}
+	if ApplyTheme(saved) == nil {
+		s.Theme = saved
+		s.refreshStyles()
+	}
+}
+
+// refreshStyles recreates the list delegate and filter styles after a theme change.
+func (s *SettingsState) refreshStyles() {
+	s.List.SetDelegate(withItemZones(NewLeagueListDelegate()))
+	filterCursorStyle, filterPromptStyle := FilterInputStyles()
+	s.List.Styles.FilterCursor = filterCursorStyle
+	s.List.FilterInput.PromptStyle = filterPromptStyle
+	s.List.FilterInput.Cursor.Style = filterCursorStyle
+}
+
+// Save persists the current selection and theme to the profile's settings file,
+// keeping its favourite teams and notification rules.
+// Selected leagues missing from the catalogue (e.g., the cache was cleared) are kept.
 func (s *SettingsState) Save() error {
+	if s.LoadErr != nil {
+		return s.LoadErr
+	}
+	settings, err := data.LoadSettings()
+	if err != nil {
+		return err
+	}
+
 	var selectedIDs []int
+	known := make(map[int]bool, len(s.AllLeagues))
 	for _, league := range s.AllLeagues {
+		known[league.ID] = true
 		if s.Selected[league.ID] {
 			selectedIDs = append(selectedIDs, league.ID)
 		}
 	}
+	var unknownIDs []int
+	for id, isSelected := range s.Selected {
+		if isSelected && !known[id] {
+			unknownIDs = append(unknownIDs, id)
+		}
+	}
+	sort.Ints(unknownIDs)
+	selectedIDs = append(selectedIDs, unknownIDs...)
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
+	settings.SelectedLeagues = selectedIDs
+	if s.Theme != data.ThemeName() {
+		settings.Theme = s.Theme
 	}
 
-	err := data.SaveSettings(settings)
+	err = data.SaveSettings(settings)
 	if err == nil {
 		s.HasChanges = false
 	}
@@ -189,7 +320,7 @@ func renderTabBar(regions []string, currentRegion int, width int) string {
 				Padding(0, 2)
 		}
 
-		tabElements = append(tabElements, tabStyle.Render(region))
+		tabElements = append(tabElements, markZone("tab:"+strconv.Itoa(i), tabStyle.Render(region)))
 	}
 
 	// Join tabs with separator
@@ -245,16 +376,19 @@ func RenderSettingsView(width, height int, state *SettingsState, bannerType cons
 	// Selection info
 	selectedCount := state.SelectedCount()
 	var infoText string
-	if selectedCount == 0 {
+	if state.LoadErr != nil {
+		infoText = "Settings file is invalid, changes won't be saved"
+	} else if selectedCount == 0 {
 		infoText = "No selection = default leagues"
 	} else {
 		infoText = fmt.Sprintf("%d of %d selected", selectedCount, len(state.AllLeagues))
 	}
+	infoText = fmt.Sprintf("Profile: %s  •  Theme: %s  •  %s", state.Profile, state.Theme, infoText)
 	infoStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
 	info := infoStyle.Render(infoText)
 
 	// Help text - update to include tab navigation
-	helpText := constants.HelpSettingsView
+	helpText := settingsHelp()
 	helpStyle := neonDimStyle.Width(settingsBoxWidth).Align(lipgloss.Center)
 	help := helpStyle.Render(helpText)
 
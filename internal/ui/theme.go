@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"github.com/0xjuanma/golazo/internal/theme"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// detectedProfile is the terminal's color profile, restored when leaving a NoColor theme.
+var detectedProfile = lipgloss.ColorProfile()
+
+func init() {
+	applyTheme(theme.Current())
+}
+
+// ApplyTheme makes the named theme active and rebuilds every style derived from it.
+// Components that copy styles when created (list delegates, the logo) must be recreated.
+func ApplyTheme(name string) error {
+	t, err := theme.Get(name)
+	if err != nil {
+		return err
+	}
+	applyTheme(t)
+	return nil
+}
+
+// applyTheme sets the palette and rebuilds the package styles.
+func applyTheme(t theme.Theme) {
+	theme.Set(t)
+	if t.NoColor {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	} else {
+		lipgloss.SetColorProfile(detectedProfile)
+	}
+
+	applyNeonColors(t)
+	applyColorAliases()
+	buildNeonStyles()
+	buildDialogStyles()
+	buildMenuStyles()
+}
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Mouse zones: clickable parts of a view are wrapped in zero-width markers when
+// rendered, and ScanZones records where they ended up on screen before removing
+// the markers. Positions always match the last rendered frame, however the
+// parts were padded, centered or joined.
+
+// Panel zones.
+const (
+	ZoneMatchList = "matches" // Match list panel of the live and stats views
+	ZoneDetails   = "details" // Match details panel of the stats view
+)
+
+// zoneBase is the first marker number, kept clear of numbers used by real escape sequences.
+const zoneBase = 1000
+
+// zoneMarkerPattern matches a zone marker, a CSI sequence that terminals ignore.
+var zoneMarkerPattern = regexp.MustCompile(`\x1b\[(\d+)z`)
+
+// zoneBounds is the screen area of a zone, in cells, right and bottom excluded.
+type zoneBounds struct {
+	left, top, right, bottom int
+}
+
+var (
+	zoneNumbers = make(map[string]int) // Zone ID -> marker number
+	zoneIDs     []string               // Marker number - zoneBase -> zone ID
+	zones       = make(map[string]zoneBounds)
+)
+
+// markZone wraps s in the markers of zone id. Every line is padded to the widest,
+// so a multi-line zone is a rectangle.
+func markZone(id, s string) string {
+	n, ok := zoneNumbers[id]
+	if !ok {
+		n = zoneBase + len(zoneIDs)
+		zoneNumbers[id] = n
+		zoneIDs = append(zoneIDs, id)
+	}
+	if strings.Contains(s, "\n") {
+		width := lipgloss.Width(s)
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			lines[i] = line + strings.Repeat(" ", width-lipgloss.Width(line))
+		}
+		s = strings.Join(lines, "\n")
+	}
+	marker := fmt.Sprintf("\x1b[%dz", n)
+	return marker + s + marker
+}
+
+// ScanZones records the position of every marked zone in a rendered view and
+// returns the view without markers. Call it on the full frame, once per View.
+func ScanZones(view string) string {
+	clear(zones)
+	open := make(map[string]zoneBounds)
+
+	lines := strings.Split(view, "\n")
+	for y, line := range lines {
+		locs := zoneMarkerPattern.FindAllStringSubmatchIndex(line, -1)
+		if len(locs) == 0 {
+			continue
+		}
+
+		var b strings.Builder
+		last := 0
+		for _, loc := range locs {
+			b.WriteString(line[last:loc[0]])
+			last = loc[1]
+
+			n, _ := strconv.Atoi(line[loc[2]:loc[3]])
+			if n < zoneBase || n-zoneBase >= len(zoneIDs) {
+				b.WriteString(line[loc[0]:loc[1]]) // Not one of ours
+				continue
+			}
+			id := zoneIDs[n-zoneBase]
+			x := lipgloss.Width(b.String())
+
+			if z, ok := open[id]; ok {
+				z.right, z.bottom = x, y+1
+				zones[id] = z
+				delete(open, id)
+			} else {
+				open[id] = zoneBounds{left: x, top: y}
+			}
+		}
+		b.WriteString(line[last:])
+		lines[y] = b.String()
+	}
+	// Zones left open were cut off by truncation and can't be clicked
+	return strings.Join(lines, "\n")
+}
+
+// InZone reports whether the cell at x, y is part of zone id in the last frame.
+func InZone(id string, x, y int) bool {
+	z, ok := zones[id]
+	return ok && x >= z.left && x < z.right && y >= z.top && y < z.bottom
+}
+
+// zoneIndexAt returns the index of the zone named prefix+index at x, y.
+func zoneIndexAt(prefix string, x, y int) (int, bool) {
+	for id := range zones {
+		rest, ok := strings.CutPrefix(id, prefix)
+		if !ok || !InZone(id, x, y) {
+			continue
+		}
+		if i, err := strconv.Atoi(rest); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ItemAt returns the index, among the visible items, of the list item on row y.
+// Items are matched by row only, so the whole width of the list can be clicked.
+func ItemAt(y int) (int, bool) {
+	for id, z := range zones {
+		rest, ok := strings.CutPrefix(id, "item:")
+		if !ok || y < z.top || y >= z.bottom {
+			continue
+		}
+		if i, err := strconv.Atoi(rest); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// TabAt returns the index of the settings tab at x, y.
+func TabAt(x, y int) (int, bool) {
+	return zoneIndexAt("tab:", x, y)
+}
+
+// TileAt returns the index of the live grid tile at x, y.
+func TileAt(x, y int) (int, bool) {
+	return zoneIndexAt("tile:", x, y)
+}
+
+// DateRangeAt returns the days of the stats date range label at x, y.
+func DateRangeAt(x, y int) (int, bool) {
+	return zoneIndexAt("range:", x, y)
+}
+
+// itemZones wraps a list delegate so every rendered item is a zone (see ItemAt).
+type itemZones struct {
+	list.ItemDelegate
+}
+
+// withItemZones makes the items rendered by d clickable.
+func withItemZones(d list.ItemDelegate) list.ItemDelegate {
+	return itemZones{d}
+}
+
+// Render renders the item with the wrapped delegate and marks it with its index.
+func (d itemZones) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	var b strings.Builder
+	d.ItemDelegate.Render(&b, m, index, item)
+	_, _ = io.WriteString(w, markZone("item:"+strconv.Itoa(index), b.String()))
+}
@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestScanZones(t *testing.T) {
+	styled := lipgloss.NewStyle().Bold(true).Render("ab") // Escape codes take no cells
+	block := "xx\nyyyy"                                   // Padded to a 4x2 rectangle when marked
+
+	tests := []struct {
+		view    string
+		plain   string // View without markers
+		id      string
+		inside  [][2]int // Cells in the zone
+		outside [][2]int // Cells out of it
+		desc    string
+	}{
+		{
+			view:    "12" + markZone("z:a", "abc") + "3",
+			plain:   "12abc3",
+			id:      "z:a",
+			inside:  [][2]int{{2, 0}, {4, 0}},
+			outside: [][2]int{{1, 0}, {5, 0}, {2, 1}},
+			desc:    "single line",
+		},
+		{
+			view:    styled + markZone("z:b", "cd"),
+			plain:   styled + "cd",
+			id:      "z:b",
+			inside:  [][2]int{{2, 0}, {3, 0}},
+			outside: [][2]int{{1, 0}},
+			desc:    "after styled text",
+		},
+		{
+			view:    lipgloss.JoinHorizontal(lipgloss.Top, "|\n|\n|", markZone("z:c", block)),
+			plain:   lipgloss.JoinHorizontal(lipgloss.Top, "|\n|\n|", "xx  \nyyyy"),
+			id:      "z:c",
+			inside:  [][2]int{{1, 0}, {4, 1}, {3, 0}},
+			outside: [][2]int{{0, 0}, {5, 1}, {1, 2}},
+			desc:    "multi-line block joined beside another",
+		},
+		{
+			view:    "top\n" + strings.SplitN(markZone("z:d", "cut\noff"), "\n", 2)[0],
+			plain:   "top\ncut",
+			id:      "z:d",
+			outside: [][2]int{{0, 1}, {1, 1}},
+			desc:    "zone cut off by truncation",
+		},
+		{
+			view:   "\x1b[5z" + markZone("z:e", "e"),
+			plain:  "\x1b[5ze",
+			id:     "z:e",
+			inside: [][2]int{{0, 0}},
+			desc:   "other escape sequences kept",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := ScanZones(tt.view); got != tt.plain {
+			t.Errorf("ScanZones() = %q; want %q - %s", got, tt.plain, tt.desc)
+		}
+		for _, c := range tt.inside {
+			if !InZone(tt.id, c[0], c[1]) {
+				t.Errorf("InZone(%d, %d) = false; want true - %s", c[0], c[1], tt.desc)
+			}
+		}
+		for _, c := range tt.outside {
+			if InZone(tt.id, c[0], c[1]) {
+				t.Errorf("InZone(%d, %d) = true; want false - %s", c[0], c[1], tt.desc)
+			}
+		}
+	}
+}
+
+func TestZoneLookups(t *testing.T) {
+	view := lipgloss.JoinVertical(lipgloss.Left,
+		markZone("tab:0", "Europe")+" "+markZone("tab:1", "America"),
+		markZone("item:0", "first"),
+		"  "+markZone("item:1", "second"),
+	)
+	ScanZones(view)
+
+	tests := []struct {
+		got    int
+		ok     bool
+		want   int
+		wantOK bool
+		desc   string
+	}{
+		{fst(TabAt(0, 0)), snd(TabAt(0, 0)), 0, true, "first tab"},
+		{fst(TabAt(8, 0)), snd(TabAt(8, 0)), 1, true, "second tab"},
+		{fst(TabAt(6, 0)), snd(TabAt(6, 0)), 0, false, "between tabs"},
+		{fst(ItemAt(1)), snd(ItemAt(1)), 0, true, "first item"},
+		{fst(ItemAt(2)), snd(ItemAt(2)), 1, true, "second item, matched by row only"},
+		{fst(ItemAt(3)), snd(ItemAt(3)), 0, false, "below the items"},
+	}
+
+	for _, tt := range tests {
+		if tt.ok != tt.wantOK || (tt.ok && tt.got != tt.want) {
+			t.Errorf("lookup = %d, %v; want %d, %v - %s", tt.got, tt.ok, tt.want, tt.wantOK, tt.desc)
+		}
+	}
+}
+
+func fst(i int, _ bool) int   { return i }
+func snd(_ int, ok bool) bool { return ok }